@@ -0,0 +1,88 @@
+package app
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	supplyExported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+	"github.com/maxonrow/maxonrow-go/genesis"
+)
+
+// initAccounts sets the genesis accounts as they are, including their public keys, sequences and multisig.
+// The accounts exported from a chain keep their account numbers, the accounts of a new chain are numbered in order.
+func (app *mxwApp) initAccounts(ctx sdkTypes.Context, genesisState genesis.GenesisState) {
+	if !genesisState.KeepAccountNumbers {
+		sdkAuth.InitGenesis(ctx, app.accountKeeper, genesisState.AuthState)
+
+		for _, initialAccount := range genesisState.Accounts {
+			initialAccount.AccountNumber = app.accountKeeper.GetNextAccountNumber(ctx)
+			app.accountKeeper.SetAccount(ctx, initialAccount)
+			app.logger.Info(fmt.Sprintf("Account %v starting with %v coins", initialAccount.Address.String(), initialAccount.Coins))
+		}
+
+		return
+	}
+
+	authState := genesisState.AuthState
+	authState.Accounts = nil
+	sdkAuth.InitGenesis(ctx, app.accountKeeper, authState)
+
+	var nextAccountNumber uint64
+	for _, moduleAccount := range genesisState.AuthState.Accounts {
+		if moduleAccount.GetAccountNumber() >= nextAccountNumber {
+			nextAccountNumber = moduleAccount.GetAccountNumber() + 1
+		}
+
+		app.accountKeeper.SetAccount(ctx, moduleAccount)
+	}
+
+	for _, initialAccount := range genesisState.Accounts {
+		if initialAccount.AccountNumber >= nextAccountNumber {
+			nextAccountNumber = initialAccount.AccountNumber + 1
+		}
+
+		app.accountKeeper.SetAccount(ctx, initialAccount)
+		app.logger.Info(fmt.Sprintf("Account %v starting with %v coins", initialAccount.Address.String(), initialAccount.Coins))
+	}
+
+	// the accounts created after genesis are numbered after the restored ones
+	store := ctx.KVStore(app.keyAccount)
+	store.Set(sdkAuth.GlobalAccountNumberKey, app.cdc.MustMarshalBinaryLengthPrefixed(nextAccountNumber))
+}
+
+// exportAccounts returns the accounts with everything initAccounts needs to restore them.
+// The module accounts are exported in the auth state, the other accounts are returned.
+func (app *mxwApp) exportAccounts(ctx sdkTypes.Context) (sdkAuth.GenesisState, []*sdkAuth.BaseAccount) {
+	authState := sdkAuth.NewGenesisState(app.accountKeeper.GetParams(ctx), nil)
+	accounts := []*sdkAuth.BaseAccount{}
+
+	app.accountKeeper.IterateAccounts(ctx, func(acc exported.Account) bool {
+		if moduleAccount, ok := acc.(supplyExported.ModuleAccountI); ok {
+			authState.Accounts = append(authState.Accounts, moduleAccount.(exported.GenesisAccount))
+			return false
+		}
+
+		// the pending txs of multisig accounts are exported by the mxw auth module
+		multiSig := acc.GetMultiSig()
+		if multiSig != nil {
+			multiSigCopy := *multiSig
+			multiSigCopy.PendingTxs = nil
+			multiSig = &multiSigCopy
+		}
+
+		accounts = append(accounts, &sdkAuth.BaseAccount{
+			Address:       acc.GetAddress(),
+			Coins:         acc.GetCoins(),
+			PubKey:        acc.GetPubKey(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+			MultiSig:      multiSig,
+		})
+
+		return false
+	})
+
+	return authState, accounts
+}
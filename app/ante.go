@@ -8,31 +8,36 @@ import (
 	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/maxonrow/maxonrow-go/types"
+	"github.com/maxonrow/maxonrow-go/x/bank"
 	fungible "github.com/maxonrow/maxonrow-go/x/token/fungible"
 	nonFungible "github.com/maxonrow/maxonrow-go/x/token/nonfungible"
-	rpc "github.com/tendermint/tendermint/rpc/core"
 )
 
 func (app *mxwApp) NewAnteHandler() sdkTypes.AnteHandler {
+	return app.newAnteHandler(false)
+}
+
+// newAnteHandler checks the tx, pays its fee and verifies its signature.
+// The pending tx of a multisig account has no signature, it is signed by the signers of the group account instead,
+// so it is only run by runMultiSigTx once the signatures reach the threshold, never through the public ante handler.
+func (app *mxwApp) newAnteHandler(isMultiSigTx bool) sdkTypes.AnteHandler {
 	return func(
 		ctx sdkTypes.Context, tx sdkTypes.Tx, simulate bool,
 	) (sdkTypes.Context, error) {
 
-		// HACK:
-		// When the create-non-empty-block is set to false, and the node restarts;
-		// signature verification fails, because chain-id doesn't set properly, it's empty.
-		// Two ways to set the chain-id:
-		// 1- Through genesis block
-		// 2- Creating a new block
-		// Set chain-id for verify the signature when non-empty blocks in false
-		// chain := app.deliverState.ctx.ChainID()
-
+		// The context has no chain id in CheckTx after the node restarts,
+		// it is read from the state where initChainer keeps it.
 		var chainID = ctx.ChainID()
 		if chainID == "" {
-			chainID, blockHeight := app.retrieveChainID()
+			chainID = app.getChainID(ctx)
+			if chainID == "" {
+				return ctx, sdkTypes.ErrInternal("Chain id is not set yet.")
+			}
 
 			ctx = ctx.WithChainID(chainID)
-			ctx = ctx.WithBlockHeight(blockHeight)
+			if ctx.BlockHeight() == 0 {
+				ctx = ctx.WithBlockHeight(app.LastBlockHeight())
+			}
 		}
 
 		stdTx, ok := tx.(sdkAuth.StdTx)
@@ -45,8 +50,10 @@ func (app *mxwApp) NewAnteHandler() sdkTypes.AnteHandler {
 			return ctx, sdkTypes.ErrInternal(fmt.Sprintf("Fee is missed. Make sure you have entered the fee amount in cin"))
 		}
 
-		if stdTx.Fee.Amount[0].Denom != types.CIN {
-			return ctx, sdkTypes.ErrInternal(fmt.Sprintf("Invalid denom for fee. Fee should pay by cin."))
+		// fee can be paid by cin or by a fungible token accepted to pay fees
+		feeDenom := stdTx.Fee.Amount[0].Denom
+		if feeDenom != types.CIN && !app.feeKeeper.IsFeeToken(ctx, feeDenom) {
+			return ctx, sdkTypes.ErrInternal(fmt.Sprintf("Invalid denom for fee. Fee should pay by cin or by a fee token."))
 		}
 
 		if stdTx.Fee.Gas != 0 {
@@ -57,14 +64,18 @@ func (app *mxwApp) NewAnteHandler() sdkTypes.AnteHandler {
 
 		signer := stdTx.GetMsgs()[0].GetSigners()[0]
 		signerAcc := app.accountKeeper.GetAccount(ctx, signer)
-		if signerAcc.GetMultiSig() == nil {
+		if isMultiSigTx && (signerAcc == nil || signerAcc.GetMultiSig() == nil) {
+			return ctx, sdkTypes.ErrUnauthorized("Pending tx must be signed by a multisig account.")
+		}
+
+		if !isMultiSigTx {
 			if err := tx.ValidateBasic(); err != nil {
 				return ctx, err
 			}
 
 		}
 
-		if err := app.ValidateMemo(stdTx, params); err != nil {
+		if err := app.ValidateMemo(ctx, stdTx, params); err != nil {
 			return ctx, err
 		}
 
@@ -82,7 +93,7 @@ func (app *mxwApp) NewAnteHandler() sdkTypes.AnteHandler {
 		}
 
 		stdSigs := stdTx.Signatures
-		if signerAcc.GetMultiSig() == nil {
+		if !isMultiSigTx {
 			if len(stdSigs) != 1 {
 				return ctx, sdkTypes.ErrInternal(fmt.Sprintf("MXW transactions accept only one signature. it has %v signatures", len(stdSigs)))
 			}
@@ -93,23 +104,34 @@ func (app *mxwApp) NewAnteHandler() sdkTypes.AnteHandler {
 			return ctx, err
 		}
 
+		useFeeAllowance, err := getFeeAllowance(stdTx)
+		if err != nil {
+			return ctx, err
+		}
+
 		// removing this condition will cause app-hash change
 		if !stdTx.Fee.Amount.IsZero() {
-			err = sdkAuth.DeductFees(app.supplyKeeper, ctx, signerAcc, stdTx.Fee.Amount)
+			if useFeeAllowance != nil {
+				err = app.payFeeWithAllowance(ctx, stdTx, *useFeeAllowance)
+			} else if feeDenom == types.CIN {
+				err = sdkAuth.DeductFees(app.supplyKeeper, ctx, signerAcc, stdTx.Fee.Amount)
+			} else {
+				err = app.payFeeInToken(ctx, signerAcc.GetAddress(), stdTx.Fee.Amount[0])
+			}
 			if err != nil {
 				return ctx, err
 			}
 
 			signerAcc = app.accountKeeper.GetAccount(ctx, signerAcc.GetAddress())
 		}
-		
+
 		signBytes := stdTx.GetSignBytes(ctx, signerAcc)
 
 		var stdSig sdkAuth.StdSignature
 		if stdSigs != nil {
 			stdSig = stdSigs[0]
 		}
-		if signerAcc.GetMultiSig() == nil {
+		if !isMultiSigTx {
 			signerAcc, err = processSig(ctx, signerAcc, stdSig, signBytes, simulate, params)
 			if err != nil {
 				return ctx, err
@@ -118,6 +140,10 @@ func (app *mxwApp) NewAnteHandler() sdkTypes.AnteHandler {
 
 		app.accountKeeper.SetAccount(ctx, signerAcc)
 
+		if err := app.CheckTransferLimits(ctx, stdTx); err != nil {
+			return ctx, err
+		}
+
 		for _, msg := range stdTx.GetMsgs() {
 
 			if !ok {
@@ -200,32 +226,7 @@ func processSig(
 	return acc, err
 }
 
-func (app *mxwApp) retrieveChainID() (string, int64) {
-	if app.chainID == "" {
-		// calling status caused having deadlock since it try to get information from consensus engine
-		//status, err := rpc.Status(nil)
-		//if err != nil {
-		//	panic(err)
-		//}
-		//num := int64(status.SyncInfo.LatestBlockHeight - 1)
-
-		num := int64(1)
-		res, err := rpc.Block(nil, &num)
-		if err != nil {
-			panic(err)
-		}
-		if res == nil {
-			panic(err)
-		}
-
-		app.blockHeight = num
-		app.chainID = string(res.Block.ChainID)
-	}
-
-	return app.chainID, app.blockHeight
-}
-
-func (app *mxwApp) ValidateMemo(tx sdkAuth.StdTx, params sdkAuth.Params) error {
+func (app *mxwApp) ValidateMemo(ctx sdkTypes.Context, tx sdkAuth.StdTx, params sdkAuth.Params) error {
 	memo := tx.GetMemo()
 
 	memoLength := len(memo)
@@ -235,5 +236,85 @@ func (app *mxwApp) ValidateMemo(tx sdkAuth.StdTx, params sdkAuth.Params) error {
 			params.MaxMemoCharacters, memoLength))
 	}
 
+	timeoutHeight, memo, err := types.ParseTimeoutMemo(memo)
+	if err != nil {
+		return sdkTypes.ErrUnknownRequest(err.Error())
+	}
+
+	if timeoutHeight > 0 && ctx.BlockHeight() > timeoutHeight {
+		return types.ErrTxTimeout(timeoutHeight, ctx.BlockHeight())
+	}
+
+	// the timeout alone doesn't count as a memo
+	if len(memo) == 0 {
+		for _, recipient := range getRecipients(tx) {
+			if app.mxwAuthKeeper.IsMemoRequired(ctx, recipient) {
+				return types.ErrMemoRequired(recipient.String())
+			}
+		}
+	}
+
+	return nil
+}
+
+// getRecipients returns the recipients of the transfers in the tx, which can require a memo.
+func getRecipients(tx sdkAuth.StdTx) []sdkTypes.AccAddress {
+	var recipients []sdkTypes.AccAddress
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case bank.MsgMxwSend:
+			recipients = append(recipients, msg.ToAddress)
+		case bank.MsgMxwMultiSend:
+			for _, output := range msg.Outputs {
+				recipients = append(recipients, output.Address)
+			}
+		case fungible.MsgTransferFungibleToken:
+			recipients = append(recipients, msg.To)
+		}
+	}
+
+	return recipients
+}
+
+// CheckTransferLimits rejects the tx early if one of its transfers would exceed the limit of the kyc tier of the sender.
+// It doesn't record the transfers, the handlers do that so a failed msg doesn't count towards the limits.
+func (app *mxwApp) CheckTransferLimits(ctx sdkTypes.Context, tx sdkAuth.StdTx) error {
+	for _, transfer := range getTransfers(tx) {
+		if err := app.kycKeeper.CheckTransferLimit(ctx, transfer.from, transfer.denom, transfer.amount); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+type transfer struct {
+	from   sdkTypes.AccAddress
+	denom  string
+	amount sdkTypes.Uint
+}
+
+// getTransfers returns the transfers of cin and of fungible tokens in the tx, which count towards the transfer limits.
+func getTransfers(tx sdkAuth.StdTx) []transfer {
+	var transfers []transfer
+	appendCoins := func(from sdkTypes.AccAddress, coins sdkTypes.Coins) {
+		for _, coin := range coins {
+			transfers = append(transfers, transfer{from, coin.Denom, sdkTypes.NewUintFromBigInt(coin.Amount.BigInt())})
+		}
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case bank.MsgMxwSend:
+			appendCoins(msg.FromAddress, msg.Amount)
+		case bank.MsgMxwMultiSend:
+			for _, output := range msg.Outputs {
+				appendCoins(msg.FromAddress, output.Coins)
+			}
+		case fungible.MsgTransferFungibleToken:
+			transfers = append(transfers, transfer{msg.From, msg.Symbol, msg.Value})
+		}
+	}
+
+	return transfers
+}
@@ -0,0 +1,160 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/maxonrow/maxonrow-go/genesis"
+	"github.com/maxonrow/maxonrow-go/x/auth"
+	"github.com/maxonrow/maxonrow-go/x/bank"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestMemoRequired(t *testing.T) {
+	priv, _, sender := KeyTestPubAddr()
+	_, _, exchange := KeyTestPubAddr()
+
+	gen := genesis.NewDefaultGenesisState()
+	for _, addr := range []sdkTypes.AccAddress{sender, exchange} {
+		acc := sdkAuth.NewBaseAccountWithAddress(addr)
+		acc.Coins = sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(100000000000000000)))
+		gen.Accounts = append(gen.Accounts, &acc)
+	}
+	gen.FeeState.AuthorisedAddresses = []sdkTypes.AccAddress{sender}
+	gen.MxwAuthState.MemoRequiredAccounts = []sdkTypes.AccAddress{exchange}
+
+	appState, err := MakeDefaultCodec().MarshalJSON(gen)
+	assert.NoError(t, err)
+
+	app := NewMXWApp(log.NewNopLogger(), dbm.NewMemDB())
+	app.InitChain(abci.RequestInitChain{ChainId: "maxonrow-chain", AppStateBytes: appState})
+	app.Commit()
+	ctx := app.NewContext(true, abci.Header{ChainID: "maxonrow-chain", Height: 2})
+	anteHandler := app.NewAnteHandler()
+
+	assert.True(t, app.mxwAuthKeeper.IsMemoRequired(ctx, exchange))
+	assert.False(t, app.mxwAuthKeeper.IsMemoRequired(ctx, sender))
+
+	minFee := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(10000000000000000)))
+	send := bank.NewMsgSend(sender, exchange, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000))))
+
+	_, anteErr := anteHandler(ctx, signTx(t, app, ctx, priv, []sdkTypes.Msg{send}, minFee), false)
+	assert.Error(t, anteErr)
+
+	_, anteErr = anteHandler(ctx, signTxWithMemo(t, app, ctx, priv, []sdkTypes.Msg{send}, minFee, "deposit 1234"), false)
+	assert.NoError(t, anteErr)
+
+	// the account RPC shows the flag next to the account
+	out, err := app.Account(nil, exchange.String())
+	assert.NoError(t, err)
+	var info AccountInfo
+	assert.NoError(t, json.Unmarshal([]byte(out), &info))
+	assert.True(t, info.MemoRequired)
+
+	// the flag is removed by its owner
+	handler := auth.NewHandler(app.accountKeeper, app.kycKeeper, &app.mxwAuthKeeper, app.txEncoder, app.runMultiSigTx)
+	result := handler(ctx, auth.NewMsgSetMemoRequired(exchange, false))
+	assert.True(t, result.IsOK())
+	assert.False(t, app.mxwAuthKeeper.IsMemoRequired(ctx, exchange))
+
+	_, anteErr = anteHandler(ctx, signTx(t, app, ctx, priv, []sdkTypes.Msg{send}, minFee), false)
+	assert.NoError(t, anteErr)
+}
+
+func TestTxTimeout(t *testing.T) {
+	priv, _, sender := KeyTestPubAddr()
+	_, _, receiver := KeyTestPubAddr()
+
+	gen := genesis.NewDefaultGenesisState()
+	acc := sdkAuth.NewBaseAccountWithAddress(sender)
+	acc.Coins = sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(100000000000000000)))
+	gen.Accounts = append(gen.Accounts, &acc)
+	gen.FeeState.AuthorisedAddresses = []sdkTypes.AccAddress{sender}
+
+	appState, err := MakeDefaultCodec().MarshalJSON(gen)
+	assert.NoError(t, err)
+
+	app := NewMXWApp(log.NewNopLogger(), dbm.NewMemDB())
+	app.InitChain(abci.RequestInitChain{ChainId: "maxonrow-chain", AppStateBytes: appState})
+	app.Commit()
+	ctx := app.NewContext(true, abci.Header{ChainID: "maxonrow-chain", Height: 10})
+	anteHandler := app.NewAnteHandler()
+
+	minFee := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(10000000000000000)))
+	send := bank.NewMsgSend(sender, receiver, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000))))
+
+	_, anteErr := anteHandler(ctx, signTxWithMemo(t, app, ctx, priv, []sdkTypes.Msg{send}, minFee, "timeout:9;late"), false)
+	assert.Error(t, anteErr)
+
+	_, anteErr = anteHandler(ctx, signTxWithMemo(t, app, ctx, priv, []sdkTypes.Msg{send}, minFee, "timeout:x"), false)
+	assert.Error(t, anteErr)
+
+	_, anteErr = anteHandler(ctx, signTxWithMemo(t, app, ctx, priv, []sdkTypes.Msg{send}, minFee, "timeout:10;on time"), false)
+	assert.NoError(t, anteErr)
+
+	// encode_tx writes the timeout into the memo and decode_tx shows it
+	unsigned := sdkAuth.NewStdTx([]sdkTypes.Msg{send}, sdkAuth.NewStdFee(0, minFee), nil, "deposit")
+	js, err := app.cdc.MarshalJSON(unsigned)
+	assert.NoError(t, err)
+	var info TxInfo
+	assert.NoError(t, json.Unmarshal(js, &info))
+	info.TimeoutHeight = 1200
+	js, err = json.Marshal(info)
+	assert.NoError(t, err)
+
+	bz, err := app.EncodeTx(nil, string(js))
+	assert.NoError(t, err)
+	decoded, err := app.DecodeTx(nil, bz)
+	assert.NoError(t, err)
+
+	var decodedInfo TxInfo
+	assert.NoError(t, json.Unmarshal([]byte(decoded), &decodedInfo))
+	assert.Equal(t, int64(1200), decodedInfo.TimeoutHeight)
+
+	var decodedTx sdkAuth.StdTx
+	assert.NoError(t, app.cdc.UnmarshalJSON([]byte(decoded), &decodedTx))
+	assert.Equal(t, "timeout:1200;deposit", decodedTx.Memo)
+}
+
+func TestChainIDFromState(t *testing.T) {
+	priv, _, sender := KeyTestPubAddr()
+	_, _, receiver := KeyTestPubAddr()
+
+	gen := genesis.NewDefaultGenesisState()
+	acc := sdkAuth.NewBaseAccountWithAddress(sender)
+	acc.Coins = sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(100000000000000000)))
+	gen.Accounts = append(gen.Accounts, &acc)
+	gen.FeeState.AuthorisedAddresses = []sdkTypes.AccAddress{sender}
+
+	appState, err := MakeDefaultCodec().MarshalJSON(gen)
+	assert.NoError(t, err)
+
+	app := NewMXWApp(log.NewNopLogger(), dbm.NewMemDB())
+	app.InitChain(abci.RequestInitChain{ChainId: "maxonrow-chain", AppStateBytes: appState})
+	app.Commit()
+
+	signCtx := app.NewContext(true, abci.Header{ChainID: "maxonrow-chain", Height: 2})
+	minFee := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(10000000000000000)))
+	send := bank.NewMsgSend(sender, receiver, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000))))
+	tx := signTx(t, app, signCtx, priv, []sdkTypes.Msg{send}, minFee)
+
+	// like CheckTx after a restart, the context has no chain id
+	ctx := app.NewContext(true, abci.Header{})
+	assert.Equal(t, "maxonrow-chain", app.getChainID(ctx))
+
+	// chains started before keep the chain id from the next block
+	ctx.KVStore(app.keyMain).Delete(mainChainIDKey)
+	_, anteErr := app.NewAnteHandler()(ctx, tx, false)
+	assert.Error(t, anteErr)
+
+	app.migrateChainID(ctx.WithChainID("maxonrow-chain"))
+	assert.Equal(t, "maxonrow-chain", app.getChainID(ctx))
+
+	_, anteErr = app.NewAnteHandler()(ctx, tx, false)
+	assert.NoError(t, anteErr)
+}
@@ -10,7 +10,6 @@ import (
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
-	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	sdkBank "github.com/cosmos/cosmos-sdk/x/bank"
 	sdkDist "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -53,7 +52,8 @@ type mxwApp struct {
 	logger    log.Logger
 
 	// Handlers
-	authAnteHandler sdkTypes.AnteHandler
+	authAnteHandler     sdkTypes.AnteHandler
+	multiSigAnteHandler sdkTypes.AnteHandler
 
 	// Storage keys
 	keyMain         *sdkTypes.KVStoreKey
@@ -71,6 +71,7 @@ type mxwApp struct {
 	KeyKycData      *sdkTypes.KVStoreKey
 	KeyMaintenance  *sdkTypes.KVStoreKey
 	KeyValidatorSet *sdkTypes.KVStoreKey
+	keyMxwAuth      *sdkTypes.KVStoreKey
 
 	// Keepers
 	accountKeeper          sdkAuth.AccountKeeper
@@ -85,13 +86,11 @@ type mxwApp struct {
 	nonFungibleTokenKeeper nonFungible.Keeper
 	feeKeeper              fee.Keeper
 	maintenanceKeeper      maintenance.Keeper
+	mxwAuthKeeper          auth.Keeper
 
 	router sdkTypes.Router
 
 	mm *module.Manager
-
-	chainID     string
-	blockHeight int64
 }
 
 func init() {
@@ -143,6 +142,7 @@ func NewMXWApp(logger log.Logger, db dbm.DB) *mxwApp {
 		KeyKycData:      sdkTypes.NewKVStoreKey("kycData"),
 		KeyMaintenance:  sdkTypes.NewKVStoreKey("maintenance"),
 		KeyValidatorSet: sdkTypes.NewKVStoreKey("validator_set"),
+		keyMxwAuth:      sdkTypes.NewKVStoreKey("mxw_auth"),
 	}
 
 	app.txDecoder = sdkAuth.DefaultTxDecoder(cdc)
@@ -156,6 +156,7 @@ func NewMXWApp(logger log.Logger, db dbm.DB) *mxwApp {
 		sdkStaking.BondedPoolName:    []string{sdkSupply.Burner, sdkSupply.Staking},
 		sdkStaking.NotBondedPoolName: []string{sdkSupply.Burner, sdkSupply.Staking},
 		gov.ModuleName:               []string{sdkSupply.Burner},
+		fee.ModuleName:               []string{sdkSupply.Burner},
 	}
 
 	app.paramsKeeper = sdkParams.NewKeeper(
@@ -216,8 +217,10 @@ func NewMXWApp(logger log.Logger, db dbm.DB) *mxwApp {
 	app.tokenKeeper = fungible.NewKeeper(cdc, &app.accountKeeper, &app.feeKeeper, app.keyToken)
 	app.nonFungibleTokenKeeper = nonFungible.NewKeeper(cdc, &app.accountKeeper, &app.feeKeeper, app.keyToken)
 	app.feeKeeper = fee.NewKeeper(cdc, app.keyFee)
+	app.feeKeeper.SetNonFungibleTokenKeeper(&app.nonFungibleTokenKeeper)
 	app.kycKeeper = kyc.NewKeeper(cdc, &app.accountKeeper, app.KeyKyc, app.KeyKycData)
 	app.maintenanceKeeper = maintenance.NewKeeper(cdc, app.KeyMaintenance, app.KeyValidatorSet, app.executeProposal)
+	app.mxwAuthKeeper = auth.NewKeeper(cdc, app.keyMxwAuth)
 
 	// Registering hooks from distribution and slashing module to be called
 	// on different events in the consensus
@@ -227,6 +230,7 @@ func NewMXWApp(logger log.Logger, db dbm.DB) *mxwApp {
 	// AnteHandler is executed before every transaction, it verifies transactions,
 	// verifies their signatures and manages fees via feeCollectionKeeper
 	app.authAnteHandler = app.NewAnteHandler()
+	app.multiSigAnteHandler = app.newAnteHandler(true)
 	app.SetAnteHandler(app.anteHandler)
 	app.SetBeginBlocker(app.beginBlocker)
 	app.SetInitChainer(app.initChainer)
@@ -241,13 +245,13 @@ func NewMXWApp(logger log.Logger, db dbm.DB) *mxwApp {
 	)
 
 	app.Router().
-		AddRoute("auth", auth.NewHandler(app.accountKeeper, app.kycKeeper, app.txEncoder)).
-		AddRoute("bank", bank.NewHandler(app.bankKeeper, app.accountKeeper)).
+		AddRoute("auth", auth.NewHandler(app.accountKeeper, app.kycKeeper, &app.mxwAuthKeeper, app.txEncoder, app.runMultiSigTx)).
+		AddRoute("bank", bank.NewHandler(app.bankKeeper, app.accountKeeper, &app.kycKeeper)).
 		AddRoute("staking", sdkStaking.NewHandler(app.stakingKeeper)).
 		AddRoute("distribution", sdkDist.NewHandler(app.distrKeeper)).
 		AddRoute("nameservice", nameservice.NewHandler(app.nsKeeper)).
 		AddRoute("kyc", kyc.NewHandler(&app.kycKeeper)).
-		AddRoute("token", fungible.NewHandler(&app.tokenKeeper)).
+		AddRoute("token", fungible.NewHandler(&app.tokenKeeper, &app.kycKeeper)).
 		AddRoute("nonFungible", nonFungible.NewHandler(&app.nonFungibleTokenKeeper)).
 		AddRoute("fee", fee.NewHandler(&app.feeKeeper)).
 		AddRoute("maintenance", maintenance.NewHandler(&app.maintenanceKeeper, &app.accountKeeper))
@@ -263,7 +267,7 @@ func NewMXWApp(logger log.Logger, db dbm.DB) *mxwApp {
 		AddRoute("nonFungible", nonFungible.NewQuerier(app.cdc, &app.nonFungibleTokenKeeper, &app.feeKeeper)).
 		AddRoute("fee", fee.NewQuerier(app.cdc, &app.feeKeeper)).
 		AddRoute("maintenance", maintenance.NewQuerier(&app.maintenanceKeeper)).
-		AddRoute("auth", auth.NewQuerier(app.cdc, app.accountKeeper))
+		AddRoute("auth", auth.NewQuerier(app.cdc, app.accountKeeper, &app.mxwAuthKeeper))
 
 	app.router = app.Router()
 	app.MountStores(
@@ -282,6 +286,7 @@ func NewMXWApp(logger log.Logger, db dbm.DB) *mxwApp {
 		app.keyFee,
 		app.KeyMaintenance,
 		app.KeyValidatorSet,
+		app.keyMxwAuth,
 	)
 
 	if err := app.LoadLatestVersion(app.keyMain); err != nil {
@@ -289,6 +294,7 @@ func NewMXWApp(logger log.Logger, db dbm.DB) *mxwApp {
 	}
 
 	rpccore.Routes["query_fee"] = rpc.NewRPCFunc(app.QueryFee, "tx")
+	rpccore.Routes["query_fee_details"] = rpc.NewRPCFunc(app.QueryFeeDetails, "tx")
 	rpccore.Routes["latest_block_height"] = rpc.NewRPCFunc(app.GetLatestBlockHeight, "")
 	rpccore.Routes["is_whitelisted"] = rpc.NewRPCFunc(app.CheckWhitelist, "address")
 	rpccore.Routes["validator"] = rpc.NewRPCFunc(app.Validator, "address")
@@ -326,17 +332,16 @@ func (app *mxwApp) initChainer(ctx sdkTypes.Context, req abci.RequestInitChain)
 		panic(err)
 	}
 
-	sdkAuth.InitGenesis(ctx, app.accountKeeper, genesisState.AuthState)
+	app.setChainID(ctx, req.ChainId)
 
-	// Setting up initial accounts
-	for _, initialAccount := range genesisState.Accounts {
-		initialAccount.AccountNumber = app.accountKeeper.GetNextAccountNumber(ctx)
-		app.accountKeeper.SetAccount(ctx, initialAccount)
-		app.logger.Info(fmt.Sprintf("Account %v starting with %v coins", initialAccount.Address.String(), initialAccount.Coins))
-	}
+	// Setting up auth and initial accounts
+	app.initAccounts(ctx, *genesisState)
 
 	sdkBank.InitGenesis(ctx, app.bankKeeper, genesisState.BankState)
 
+	// total supply is tracked from the initial accounts, burning fees needs it
+	sdkSupply.InitGenesis(ctx, app.supplyKeeper, app.accountKeeper, sdkSupply.DefaultGenesisState())
+
 	initialValidators := sdkStaking.InitGenesis(ctx, app.stakingKeeper, app.accountKeeper, app.supplyKeeper, genesisState.StakingState)
 	for i, validator := range initialValidators {
 		app.logger.Info(fmt.Sprintf("Validator %d. Key: %s. Power: %d", i, validator.PubKey.String(), validator.Power))
@@ -345,9 +350,11 @@ func (app *mxwApp) initChainer(ctx sdkTypes.Context, req abci.RequestInitChain)
 	sdkDist.InitGenesis(ctx, app.distrKeeper, app.supplyKeeper, genesisState.DistrState)
 	kyc.InitGenesis(ctx, &app.kycKeeper, genesisState.KycState)
 	fungible.InitGenesis(ctx, &app.tokenKeeper, genesisState.TokenState)
+	nonFungible.InitGenesis(ctx, &app.nonFungibleTokenKeeper, genesisState.NonFungibleTokenState)
 	nameservice.InitGenesis(ctx, app.nsKeeper, genesisState.NameServiceState)
 	fee.InitGenesis(ctx, &app.feeKeeper, genesisState.FeeState)
 	maintenance.InitGenesis(ctx, &app.maintenanceKeeper, genesisState.MaintenanceState)
+	auth.InitGenesis(ctx, &app.mxwAuthKeeper, app.accountKeeper, genesisState.MxwAuthState)
 
 	if len(genesisState.GenTxs) > 0 {
 		for _, genTx := range genesisState.GenTxs {
@@ -374,11 +381,38 @@ func (app *mxwApp) initChainer(ctx sdkTypes.Context, req abci.RequestInitChain)
 }
 
 func (app *mxwApp) beginBlocker(ctx sdkTypes.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
-	return app.mm.BeginBlock(ctx, req)
+	app.migrateChainID(ctx)
+	app.migrateSupply(ctx)
+
+	res := app.mm.BeginBlock(ctx, req)
+
+	// fee changes scheduled for this block take effect before its txs
+	events := app.feeKeeper.ApplyScheduledFeeChanges(ctx)
+	res.Events = append(res.Events, events.ToABCIEvents()...)
+
+	return res
 }
 
 func (app *mxwApp) endBlocker(ctx sdkTypes.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	return app.mm.EndBlock(ctx, req)
+	res := app.mm.EndBlock(ctx, req)
+
+	expiredEvents := app.mxwAuthKeeper.PruneExpiredMultiSigTxs(ctx, app.accountKeeper)
+	res.Events = append(res.Events, expiredEvents.ToABCIEvents()...)
+
+	revokedEvents := app.kycKeeper.RevokeExpiredWhitelists(ctx)
+	res.Events = append(res.Events, revokedEvents.ToABCIEvents()...)
+
+	// split the fees collected in this block, before the distribution module allocates them
+	cacheCtx, write := ctx.CacheContext()
+	events, err := app.feeKeeper.DistributeFees(cacheCtx, app.feeSupplyKeeper())
+	if err != nil {
+		ctx.Logger().Error("Distributing fees failed.", "err", err.Error())
+	} else {
+		write()
+		res.Events = append(res.Events, events.ToABCIEvents()...)
+	}
+
+	return res
 }
 
 func (app *mxwApp) anteHandler(ctx sdkTypes.Context, tx sdkTypes.Tx, simulate bool) (sdkTypes.Context, error) {
@@ -395,42 +429,33 @@ func (app *mxwApp) anteHandler(ctx sdkTypes.Context, tx sdkTypes.Tx, simulate bo
 
 func (app *mxwApp) ExportStateAndValidators() (json.RawMessage, []tm.GenesisValidator, error) {
 	ctx := app.NewContext(true, abci.Header{})
-	accounts := []*sdkAuth.BaseAccount{}
-
-	appendAccountsFn := func(acc exported.Account) bool {
-		account := &sdkAuth.BaseAccount{
-			Address: acc.GetAddress(),
-			Coins:   acc.GetCoins(),
-		}
-
-		accounts = append(accounts, account)
-
-		return false
-	}
-
-	app.accountKeeper.IterateAccounts(ctx, appendAccountsFn)
+	authState, accounts := app.exportAccounts(ctx)
 
-	authState := sdkAuth.ExportGenesis(ctx, app.accountKeeper)
 	bankState := sdkBank.ExportGenesis(ctx, app.bankKeeper)
 	StakingState := sdkStaking.ExportGenesis(ctx, app.stakingKeeper)
 	distrState := sdkDist.ExportGenesis(ctx, app.distrKeeper)
-	kycState := kyc.ExportGenesis(&app.kycKeeper)
-	tokenState := fungible.ExportGenesis(&app.tokenKeeper)
-	feeState := fee.ExportGenesis(&app.feeKeeper)
-	nameServiceState := nameservice.ExportGenesis(&app.nsKeeper)
+	kycState := kyc.ExportGenesis(ctx, &app.kycKeeper)
+	tokenState := fungible.ExportGenesis(ctx, &app.tokenKeeper)
+	nonFungibleTokenState := nonFungible.ExportGenesis(ctx, &app.nonFungibleTokenKeeper)
+	feeState := fee.ExportGenesis(ctx, &app.feeKeeper)
+	nameServiceState := nameservice.ExportGenesis(ctx, &app.nsKeeper)
 	maintenanceState := maintenance.ExportGenesis(ctx, &app.maintenanceKeeper)
+	mxwAuthState := auth.ExportGenesis(ctx, &app.mxwAuthKeeper, app.accountKeeper)
 
 	appState := genesis.GenesisState{
-		AuthState:        authState,
-		Accounts:         accounts,
-		BankState:        bankState,
-		StakingState:     StakingState,
-		DistrState:       distrState,
-		KycState:         kycState,
-		TokenState:       tokenState,
-		FeeState:         feeState,
-		NameServiceState: nameServiceState,
-		MaintenanceState: maintenanceState,
+		AuthState:             authState,
+		Accounts:              accounts,
+		BankState:             bankState,
+		StakingState:          StakingState,
+		DistrState:            distrState,
+		KycState:              kycState,
+		TokenState:            tokenState,
+		NonFungibleTokenState: nonFungibleTokenState,
+		FeeState:              feeState,
+		NameServiceState:      nameServiceState,
+		MaintenanceState:      maintenanceState,
+		MxwAuthState:          mxwAuthState,
+		KeepAccountNumbers:    true,
 	}
 
 	appStateJSON, err := codec.MarshalJSONIndent(app.cdc, appState)
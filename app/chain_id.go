@@ -0,0 +1,29 @@
+package app
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+var mainChainIDKey = []byte("chain_id")
+
+// setChainID keeps the chain id in the main store, so the signatures can be verified
+// when the context has no chain id, like in CheckTx after the node restarts.
+func (app *mxwApp) setChainID(ctx sdkTypes.Context, chainID string) {
+	store := ctx.KVStore(app.keyMain)
+	store.Set(mainChainIDKey, []byte(chainID))
+}
+
+func (app *mxwApp) getChainID(ctx sdkTypes.Context) string {
+	store := ctx.KVStore(app.keyMain)
+	return string(store.Get(mainChainIDKey))
+}
+
+// migrateChainID stores the chain id of the chains started before it was kept in the state,
+// it is called in BeginBlock which has the chain id in its header.
+func (app *mxwApp) migrateChainID(ctx sdkTypes.Context) {
+	if ctx.ChainID() == "" || app.getChainID(ctx) != "" {
+		return
+	}
+
+	app.setChainID(ctx, ctx.ChainID())
+}
@@ -0,0 +1,248 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/maxonrow/maxonrow-go/genesis"
+	"github.com/maxonrow/maxonrow-go/types"
+	"github.com/maxonrow/maxonrow-go/x/auth"
+	"github.com/maxonrow/maxonrow-go/x/bank"
+	"github.com/maxonrow/maxonrow-go/x/fee"
+	"github.com/maxonrow/maxonrow-go/x/kyc"
+	"github.com/maxonrow/maxonrow-go/x/maintenance"
+	"github.com/maxonrow/maxonrow-go/x/nameservice"
+	fungible "github.com/maxonrow/maxonrow-go/x/token/fungible"
+	nonFungible "github.com/maxonrow/maxonrow-go/x/token/nonfungible"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func initAndExport(t *testing.T, appState json.RawMessage) json.RawMessage {
+	app := NewMXWApp(log.NewNopLogger(), dbm.NewMemDB())
+	app.InitChain(abci.RequestInitChain{ChainId: "maxonrow-chain", AppStateBytes: appState})
+	app.Commit()
+
+	exported, _, err := app.ExportStateAndValidators()
+	assert.NoError(t, err)
+
+	return exported
+}
+
+func TestExportStateRoundTrip(t *testing.T) {
+	_, _, owner := KeyTestPubAddr()
+	_, _, holder := KeyTestPubAddr()
+	_, _, authorised := KeyTestPubAddr()
+	_, _, issuer := KeyTestPubAddr()
+	_, _, provider := KeyTestPubAddr()
+	_, _, maintainer := KeyTestPubAddr()
+
+	gen := genesis.NewDefaultGenesisState()
+
+	for _, addr := range []sdkTypes.AccAddress{owner, holder, authorised} {
+		acc := sdkAuth.NewBaseAccountWithAddress(addr)
+		acc.Coins = sdkTypes.NewCoins(sdkTypes.NewCoin(types.CIN, sdkTypes.NewInt(1000000000)))
+		gen.Accounts = append(gen.Accounts, &acc)
+	}
+
+	gen.KycState.AuthorizedAddresses = []sdkTypes.AccAddress{authorised}
+	gen.KycState.IssuerAddresses = []sdkTypes.AccAddress{issuer}
+	gen.KycState.ProviderAddresses = []sdkTypes.AccAddress{provider}
+	gen.KycState.WhitelistedAccounts = []kyc.WhitelistedAccount{
+		{Address: owner, KycAddress: "kyc-owner"},
+		{Address: holder, KycAddress: "kyc-holder"},
+	}
+
+	gen.FeeState.AuthorisedAddresses = []sdkTypes.AccAddress{authorised}
+	gen.FeeState.TokenMultiplier = "2"
+	gen.FeeState.FeeSettings = append(gen.FeeState.FeeSettings, fee.GenesisFeeSetting{
+		Name:       "zero",
+		Min:        sdkTypes.NewCoins(sdkTypes.NewCoin(types.CIN, sdkTypes.NewInt(0))),
+		Max:        sdkTypes.NewCoins(sdkTypes.NewCoin(types.CIN, sdkTypes.NewInt(0))),
+		Percentage: "0",
+	})
+	gen.FeeState.AssignedMsgFeeSettings = []fee.AssignMsgFeeSetting{{Name: "zero", MsgType: "kyc-whitelist"}}
+	gen.FeeState.AssignedAccFeeSettings = []fee.AssignAccFeeSetting{{Name: "zero", Account: holder}}
+	gen.FeeState.AssignedTokenFeeSettings = []fee.AssignTokenFeeSetting{{Name: "zero", Symbol: "TT", Action: fee.TransferFungibleToken}}
+	gen.FeeState.FeeCollectors = []fee.GenesisFeeCollector{{Module: "token", Addresses: []sdkTypes.AccAddress{authorised}}}
+
+	gen.TokenState.AuthorizedAddresses = []sdkTypes.AccAddress{authorised}
+	gen.TokenState.Tokens = []fungible.Token{{
+		Flags:       fungible.DynamicFungibleTokenMask + fungible.ApprovedFlag,
+		Name:        "Test Token",
+		Symbol:      "TT",
+		Decimals:    8,
+		Owner:       owner,
+		Metadata:    "metadata",
+		TotalSupply: sdkTypes.NewUint(1000),
+		MaxSupply:   sdkTypes.NewUint(0),
+	}}
+	gen.TokenState.TokenAccounts = []fungible.GenesisTokenAccount{
+		{Symbol: "TT", Account: fungible.FungibleTokenAccount{Owner: owner, Balance: sdkTypes.NewUint(600)}},
+		{Symbol: "TT", Account: fungible.FungibleTokenAccount{Owner: holder, Frozen: true, Balance: sdkTypes.NewUint(400)}},
+	}
+
+	gen.NonFungibleTokenState.AuthorizedAddresses = []sdkTypes.AccAddress{authorised}
+	gen.NonFungibleTokenState.Tokens = []nonFungible.Token{{
+		Flags:         nonFungible.NonFungibleTokenMask + nonFungible.ApprovedFlag + nonFungible.TransferableFlag,
+		Name:          "Test NFT",
+		Symbol:        "TNFT",
+		Owner:         owner,
+		TotalSupply:   sdkTypes.NewUint(2),
+		TransferLimit: sdkTypes.NewUint(0),
+		MintLimit:     sdkTypes.NewUint(5),
+		EndorserList:  []sdkTypes.AccAddress{holder},
+	}}
+	gen.NonFungibleTokenState.Items = []nonFungible.GenesisItem{
+		{Symbol: "TNFT", Owner: owner, Item: nonFungible.Item{ID: "1", Properties: "p1", TransferLimit: sdkTypes.NewUint(0)}},
+		{Symbol: "TNFT", Owner: holder, Item: nonFungible.Item{ID: "2", Metadata: "m2", TransferLimit: sdkTypes.NewUint(1), Frozen: true}},
+	}
+	gen.NonFungibleTokenState.MintLimits = []nonFungible.GenesisMintLimit{
+		{Symbol: "TNFT", Owner: owner, Counter: sdkTypes.NewUint(1)},
+		{Symbol: "TNFT", Owner: holder, Counter: sdkTypes.NewUint(1)},
+	}
+
+	gen.NameServiceState.AuthorisedAddresses = []sdkTypes.AccAddress{authorised}
+	gen.NameServiceState.Aliases = []nameservice.Alias{
+		{Name: "owner", Owner: owner, Approved: true, Fee: sdkTypes.NewUint(1)},
+		{Name: "holder", Owner: holder, Approved: false, Fee: sdkTypes.NewUint(1)},
+	}
+
+	gen.MaintenanceState.Maintainers = []sdkTypes.AccAddress{maintainer}
+	gen.MaintenanceState.StartingProposalID = 2
+	gen.MaintenanceState.ValidatorSet = []string{sdkTypes.MustBech32ifyConsPub(ed25519.GenPrivKey().PubKey())}
+	gen.MaintenanceState.Proposals = []maintenance.Proposal{{
+		ProposalContent: maintenance.NewTextProposal("title", "description", maintenance.ProposalTypeModifyFee),
+		ProposalID:      1,
+		ProposalData:    maintenance.NewFeeeMaintainer("add", []sdkTypes.AccAddress{holder}, nil),
+		Status:          maintenance.StatusActive,
+		Approvers:       types.AddressHolder{maintainer},
+		SubmitTime:      time.Unix(1580000000, 0).UTC(),
+	}}
+
+	appState, err := MakeDefaultCodec().MarshalJSON(gen)
+	assert.NoError(t, err)
+
+	exported1 := initAndExport(t, appState)
+	exported2 := initAndExport(t, exported1)
+
+	// the whole app state, including the auth, staking, distribution and supply states, survives the round trip
+	assert.JSONEq(t, string(exported1), string(exported2))
+
+	var state genesis.GenesisState
+	assert.NoError(t, MakeDefaultCodec().UnmarshalJSON(exported1, &state))
+
+	assert.Len(t, state.KycState.WhitelistedAccounts, 5)
+	assert.Len(t, state.TokenState.TokenAccounts, 2)
+	assert.Len(t, state.NonFungibleTokenState.Items, 2)
+	assert.Len(t, state.NonFungibleTokenState.MintLimits, 2)
+	assert.Len(t, state.NameServiceState.Aliases, 2)
+	assert.Len(t, state.MaintenanceState.Proposals, 1)
+	assert.Equal(t, uint64(2), state.MaintenanceState.StartingProposalID)
+	assert.Equal(t, "2", state.FeeState.TokenMultiplier)
+	assert.Equal(t, []fee.AssignAccFeeSetting{{Name: "zero", Account: holder}}, state.FeeState.AssignedAccFeeSettings)
+}
+
+func TestExportMultiSigAccounts(t *testing.T) {
+	app, ctx, handler, owner, _, groupAddress := setupMultiSig(t)
+	_, _, receiver := KeyTestPubAddr()
+
+	ownerAcc := app.accountKeeper.GetAccount(ctx, owner)
+	ownerAcc.SetPubKey(ed25519.GenPrivKey().PubKey())
+	ownerAcc.SetSequence(7)
+	app.accountKeeper.SetAccount(ctx, ownerAcc)
+
+	minFee := sdkAuth.NewStdFee(0, sdkTypes.NewCoins(sdkTypes.NewCoin(types.CIN, sdkTypes.NewInt(10000000000000000))))
+	send := bank.NewMsgSend(groupAddress, receiver, sdkTypes.NewCoins(sdkTypes.NewCoin(types.CIN, sdkTypes.NewInt(1000))))
+	assert.True(t, handler(ctx, auth.NewMsgCreateMultiSigTx(groupAddress, sdkAuth.NewStdTx([]sdkTypes.Msg{send}, minFee, nil, ""), owner)).IsOK())
+
+	exported1, _, err := app.ExportStateAndValidators()
+	assert.NoError(t, err)
+
+	restored := NewMXWApp(log.NewNopLogger(), dbm.NewMemDB())
+	restored.InitChain(abci.RequestInitChain{ChainId: "maxonrow-chain", AppStateBytes: exported1})
+	restored.Commit()
+	restoredCtx := restored.NewContext(true, abci.Header{ChainID: "maxonrow-chain", Height: 2})
+
+	for _, addr := range []sdkTypes.AccAddress{owner, groupAddress} {
+		acc := app.accountKeeper.GetAccount(ctx, addr)
+		restoredAcc := restored.accountKeeper.GetAccount(restoredCtx, addr)
+		assert.Equal(t, acc.GetPubKey(), restoredAcc.GetPubKey())
+		assert.Equal(t, acc.GetAccountNumber(), restoredAcc.GetAccountNumber())
+		assert.Equal(t, acc.GetSequence(), restoredAcc.GetSequence())
+		assert.Equal(t, acc.GetCoins(), restoredAcc.GetCoins())
+	}
+
+	multiSig := restored.accountKeeper.GetAccount(restoredCtx, groupAddress).GetMultiSig()
+	assert.NotNil(t, multiSig)
+	assert.Equal(t, owner, multiSig.Owner)
+	assert.Equal(t, 2, multiSig.Threshold)
+	assert.Len(t, multiSig.Signers, 2)
+	assert.Equal(t, uint64(1), multiSig.Counter)
+	_, contains := multiSig.ContainTx(1)
+	assert.True(t, contains)
+
+	// new accounts are numbered after the restored ones
+	restoredGroupAcc := restored.accountKeeper.GetAccount(restoredCtx, groupAddress)
+	newAcc := restored.accountKeeper.NewAccountWithAddress(restoredCtx, receiver)
+	assert.True(t, newAcc.GetAccountNumber() > restoredGroupAcc.GetAccountNumber())
+
+	exported2 := initAndExport(t, exported1)
+	var state, state2 genesis.GenesisState
+	assert.NoError(t, MakeDefaultCodec().UnmarshalJSON(exported1, &state))
+	assert.NoError(t, MakeDefaultCodec().UnmarshalJSON(exported2, &state2))
+	assert.Equal(t, state.Accounts, state2.Accounts)
+	assert.Equal(t, state.AuthState, state2.AuthState)
+	assert.Equal(t, state.MxwAuthState, state2.MxwAuthState)
+	assert.Len(t, state.MxwAuthState.MultiSigPendingTxs, 1)
+	assert.NotEmpty(t, state.AuthState.Accounts)
+}
+
+func TestInitAccountNumbers(t *testing.T) {
+	_, _, addr1 := KeyTestPubAddr()
+	_, _, addr2 := KeyTestPubAddr()
+	_, _, addr3 := KeyTestPubAddr()
+
+	initAccounts := func(keepAccountNumbers bool) (*mxwApp, sdkTypes.Context) {
+		gen := genesis.NewDefaultGenesisState()
+		gen.KeepAccountNumbers = keepAccountNumbers
+		gen.FeeState.AuthorisedAddresses = []sdkTypes.AccAddress{addr1}
+		for i, addr := range []sdkTypes.AccAddress{addr1, addr2} {
+			acc := sdkAuth.NewBaseAccountWithAddress(addr)
+			acc.AccountNumber = uint64(i * 5)
+			gen.Accounts = append(gen.Accounts, &acc)
+		}
+
+		appState, err := MakeDefaultCodec().MarshalJSON(gen)
+		assert.NoError(t, err)
+
+		app := NewMXWApp(log.NewNopLogger(), dbm.NewMemDB())
+		app.InitChain(abci.RequestInitChain{ChainId: "maxonrow-chain", AppStateBytes: appState})
+		app.Commit()
+
+		return app, app.NewContext(true, abci.Header{ChainID: "maxonrow-chain", Height: 2})
+	}
+
+	// the account numbers of a new chain are assigned in order, whatever the genesis file says
+	app, ctx := initAccounts(false)
+	assert.Equal(t, uint64(0), app.accountKeeper.GetAccount(ctx, addr1).GetAccountNumber())
+	assert.Equal(t, uint64(1), app.accountKeeper.GetAccount(ctx, addr2).GetAccountNumber())
+
+	// an exported chain keeps them, even the account number zero
+	app, ctx = initAccounts(true)
+	assert.Equal(t, uint64(0), app.accountKeeper.GetAccount(ctx, addr1).GetAccountNumber())
+	assert.Equal(t, uint64(5), app.accountKeeper.GetAccount(ctx, addr2).GetAccountNumber())
+	assert.True(t, app.accountKeeper.NewAccountWithAddress(ctx, addr3).GetAccountNumber() > 5)
+
+	exported, _, err := app.ExportStateAndValidators()
+	assert.NoError(t, err)
+	var state genesis.GenesisState
+	assert.NoError(t, MakeDefaultCodec().UnmarshalJSON(exported, &state))
+	assert.True(t, state.KeepAccountNumbers)
+}
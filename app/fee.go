@@ -6,6 +6,7 @@ import (
 	"github.com/maxonrow/maxonrow-go/x/bank"
 	"github.com/maxonrow/maxonrow-go/x/fee"
 	token "github.com/maxonrow/maxonrow-go/x/token/fungible"
+	nonFungible "github.com/maxonrow/maxonrow-go/x/token/nonfungible"
 
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
@@ -18,7 +19,8 @@ func (app *mxwApp) CheckFee(ctx sdkTypes.Context, tx sdkTypes.Tx) sdkTypes.Error
 	if err != nil {
 		return err
 	}
-	if stdTx.Fee.Amount.IsAllLT(fee) {
+	feeDenom := getFeeDenom(tx)
+	if feeAmountOf(stdTx.Fee.Amount, feeDenom).LT(feeAmountOf(fee, feeDenom)) {
 		return sdkTypes.ErrInsufficientFee(fmt.Sprintf("Insufficient fee amount, need: %s", fee))
 	}
 	return nil
@@ -26,169 +28,223 @@ func (app *mxwApp) CheckFee(ctx sdkTypes.Context, tx sdkTypes.Tx) sdkTypes.Error
 
 func (app *mxwApp) CalculateFee(ctx sdkTypes.Context, tx sdkTypes.Tx) (sdkTypes.Coins, sdkTypes.Error) {
 
-	msgs := tx.GetMsgs()
+	reqs, err := app.getFeeRequests(tx)
+	if err != nil {
+		return nil, err
+	}
 
-	var fees sdkTypes.Coins
-	for _, msg := range msgs {
-		signer := msg.GetSigners()[0]
-
-		var amt sdkTypes.Coins
-		var multiplier string
-		var multiplierErr sdkTypes.Error
-		var feeSetting *fee.FeeSetting
-
-		var isCustomAction = func(msg string) bool {
-			return msg == token.MsgTypeTransferFungibleToken ||
-				msg == token.MsgTypeMintFungibleToken ||
-				msg == token.MsgTypeBurnFungibleToken ||
-				msg == token.MsgTypeTransferFungibleTokenOwnership ||
-				msg == token.MsgTypeAcceptFungibleTokenOwnership
-		}
-		r := msg.Route()
-		t := msg.Type()
-		if r == token.MsgRoute &&
-			isCustomAction(t) {
-
-			tokenFeeSetting, tokenAmt, feeSettingErr := app.getTokenFeeSetting(msg, ctx)
-			if feeSettingErr != nil {
-				return nil, feeSettingErr
-			}
-
-			multiplier, multiplierErr = app.feeKeeper.GetTokenFeeMultiplier(ctx)
-			if multiplierErr != nil {
-				return nil, sdkTypes.ErrInternal("Get fee multiplier failed.")
-			}
-
-			amt = tokenAmt
-			feeSetting = tokenFeeSetting
-
-		} else {
-
-			// try to get fee-setting by msg-type
-			feeSetting, _ = app.feeKeeper.GetMsgFeeSetting(ctx, msg.Route()+"-"+msg.Type())
-			multiplier, multiplierErr = app.feeKeeper.GetFeeMultiplier(ctx)
-			if multiplierErr != nil {
-				return nil, sdkTypes.ErrInternal("Get fee multiplier failed.")
-			}
-
-			bankMsg, ok := msg.(bank.MsgMxwSend)
-			if ok {
-				amt = bankMsg.Amount
-			}
-		}
+	_, fees, err := app.feeKeeper.QuoteFees(ctx, reqs)
+	if err != nil {
+		return nil, err
+	}
 
-		// try to get fee-setting by account.
-		// if account have fee setting overwrite it.
-		accFeeSetting, _ := app.feeKeeper.GetAccFeeSetting(ctx, signer)
-		if accFeeSetting != nil {
-			feeSetting = accFeeSetting
-		}
+	return app.convertFee(ctx, tx, fees)
+}
 
-		fee, err := calculateFee(ctx, feeSetting, multiplier, amt)
-		if err != nil {
-			return nil, err
-		}
-		fees = fees.Add(fee)
+// convertFee converts the fee in cin to the fee token the tx pays its fee with.
+func (app *mxwApp) convertFee(ctx sdkTypes.Context, tx sdkTypes.Tx, fees sdkTypes.Coins) (sdkTypes.Coins, sdkTypes.Error) {
+	feeDenom := getFeeDenom(tx)
+	if feeDenom == types.CIN {
+		return fees, nil
 	}
 
-	return fees, nil
+	return app.feeKeeper.ConvertFee(ctx, fees, feeDenom)
 }
 
-func calculateFee(ctx sdkTypes.Context, feeSetting *fee.FeeSetting, mul string, amt sdkTypes.Coins) (sdkTypes.Coins, sdkTypes.Error) {
+// payFeeInToken moves the fee paid in a fee token to the collector of the fee token.
+func (app *mxwApp) payFeeInToken(ctx sdkTypes.Context, payer sdkTypes.AccAddress, fee sdkTypes.Coin) sdkTypes.Error {
+	feeToken, err := app.feeKeeper.GetFeeToken(ctx, fee.Denom)
+	if err != nil {
+		return err
+	}
 
-	if feeSetting == nil {
-		panic("Fee setting should not be empty.")
+	if fee.Amount.IsNegative() {
+		return sdkTypes.ErrInsufficientFee("Fee amount cant be negative.")
 	}
 
-	amount := amt.AmountOf(types.CIN)
-	if amount.IsZero() {
-		return feeSetting.Min, nil
+	return app.tokenKeeper.PayFee(ctx, feeToken.Symbol, payer, feeToken.Collector, sdkTypes.NewUintFromBigInt(fee.Amount.BigInt()))
+}
+
+// feeAmountOf returns the amount of the denom without validating it, fee token symbols are not valid coin denoms.
+func feeAmountOf(fees sdkTypes.Coins, denom string) sdkTypes.Int {
+	for _, coin := range fees {
+		if coin.Denom == denom {
+			return coin.Amount
+		}
 	}
-	minFee := feeSetting.Min.AmountOf(types.CIN)
-	maxFee := feeSetting.Max.AmountOf(types.CIN)
-	percentage := sdkTypes.MustNewDecFromStr(feeSetting.Percentage)
-	multiplier := sdkTypes.MustNewDecFromStr(mul)
 
-	feeD := amount.ToDec().Mul(percentage)
-	feeD = feeD.Quo(sdkTypes.MustNewDecFromStr("100.0"))
+	return sdkTypes.ZeroInt()
+}
+
+// payFeeWithAllowance takes the fee off the allowance of the grantee and deducts it from the granter.
+func (app *mxwApp) payFeeWithAllowance(ctx sdkTypes.Context, tx sdkAuth.StdTx, useFeeAllowance fee.MsgUseFeeAllowance) error {
+	if getFeeDenom(tx) != types.CIN {
+		return sdkTypes.ErrInvalidCoins("Fee allowance can only pay fees in cin.")
+	}
 
-	fee := feeD.RoundInt()
-	if fee.LT(minFee) {
-		fee = minFee
+	var msgTypes []string
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(fee.MsgUseFeeAllowance); ok {
+			continue
+		}
+		msgTypes = append(msgTypes, msg.Route()+"-"+msg.Type())
 	}
-	if fee.GT(maxFee) {
-		fee = maxFee
+
+	err := app.feeKeeper.UseFeeAllowance(ctx, useFeeAllowance.Granter, useFeeAllowance.Grantee, tx.Fee.Amount, msgTypes)
+	if err != nil {
+		return err
 	}
 
-	feeD = fee.ToDec().Mul(multiplier)
-	fee = feeD.RoundInt()
+	granterAcc := app.accountKeeper.GetAccount(ctx, useFeeAllowance.Granter)
+	if granterAcc == nil {
+		return sdkTypes.ErrUnknownAddress(fmt.Sprintf("Fee granter %s does not exist", useFeeAllowance.Granter))
+	}
 
-	return sdkTypes.Coins{sdkTypes.NewCoin(types.CIN, fee)}, nil
+	return sdkAuth.DeductFees(app.supplyKeeper, ctx, granterAcc, tx.Fee.Amount)
 }
 
-func (app *mxwApp) getTokenFeeSetting(msg sdkTypes.Msg, ctx sdkTypes.Context) (*fee.FeeSetting, sdkTypes.Coins, sdkTypes.Error) {
+// getFeeAllowance returns the msg naming the fee granter, a tx can name only one.
+func getFeeAllowance(tx sdkAuth.StdTx) (*fee.MsgUseFeeAllowance, sdkTypes.Error) {
+	var useFeeAllowance *fee.MsgUseFeeAllowance
+	for _, msg := range tx.GetMsgs() {
+		msg, ok := msg.(fee.MsgUseFeeAllowance)
+		if !ok {
+			continue
+		}
+		if useFeeAllowance != nil {
+			return nil, sdkTypes.ErrUnknownRequest("Tx can only use one fee allowance.")
+		}
+		useFeeAllowance = &msg
+	}
 
-	var amt sdkTypes.Coins
-	var feeSetting *fee.FeeSetting
-	var feeSettingErr sdkTypes.Error
+	return useFeeAllowance, nil
+}
 
-	switch msgType := msg.(type) {
-	case token.MsgTransferFungibleToken:
+// getFeeDenom returns the denom the tx pays its fee with, cin by default.
+func getFeeDenom(tx sdkTypes.Tx) string {
+	stdTx, ok := tx.(sdkAuth.StdTx)
+	if !ok || len(stdTx.Fee.Amount) != 1 {
+		return types.CIN
+	}
 
-		feeSetting, feeSettingErr = app.feeKeeper.GetTokenFeeSetting(ctx, msgType.Symbol, fee.TransferFungibleToken)
-		if feeSettingErr != nil {
-			return nil, nil, feeSettingErr
-		}
+	return stdTx.Fee.Amount[0].Denom
+}
 
-		transferAmt := msgType.Value.String() + types.CIN
+// QuoteFee returns one fee quote per msg of the tx.
+func (app *mxwApp) QuoteFee(ctx sdkTypes.Context, tx sdkTypes.Tx) ([]fee.FeeQuote, sdkTypes.Error) {
 
-		transferAmtCoins, parseErr := sdkTypes.ParseCoins(transferAmt)
-		if parseErr != nil {
-			return nil, nil, sdkTypes.ErrUnknownRequest("Parsing value failed.")
-		}
+	reqs, err := app.getFeeRequests(tx)
+	if err != nil {
+		return nil, err
+	}
 
-		amt = transferAmtCoins
+	quotes, _, err := app.feeKeeper.QuoteFees(ctx, reqs)
+	if err != nil {
+		return nil, err
+	}
 
-	case token.MsgMintFungibleToken:
-		feeSetting, feeSettingErr = app.feeKeeper.GetTokenFeeSetting(ctx, msgType.Symbol, fee.MintFungibleToken)
-		if feeSettingErr != nil {
-			return nil, nil, feeSettingErr
+	return quotes, nil
+}
+
+func (app *mxwApp) getFeeRequests(tx sdkTypes.Tx) ([]fee.FeeRequest, sdkTypes.Error) {
+
+	msgs := tx.GetMsgs()
+
+	reqs := make([]fee.FeeRequest, 0, len(msgs))
+	for _, msg := range msgs {
+		// naming the fee granter is not charged
+		if _, ok := msg.(fee.MsgUseFeeAllowance); ok {
+			continue
 		}
 
-		mintAmt := msgType.Value.String() + types.CIN
-		mintAmtCoins, parseMintAmtErr := sdkTypes.ParseCoins(mintAmt)
-		if parseMintAmtErr != nil {
-			return nil, nil, sdkTypes.ErrUnknownRequest("Parsing value failed.")
+		req, err := getFeeRequest(msg)
+		if err != nil {
+			return nil, err
 		}
+		reqs = append(reqs, req)
+	}
 
-		amt = mintAmtCoins
+	return reqs, nil
+}
 
-	case token.MsgBurnFungibleToken:
-		feeSetting, feeSettingErr = app.feeKeeper.GetTokenFeeSetting(ctx, msgType.Symbol, fee.BurnFungibleToken)
-		if feeSettingErr != nil {
-			return nil, nil, feeSettingErr
+// getFeeRequest describes what the msg is charged for, token actions are charged per token.
+func getFeeRequest(msg sdkTypes.Msg) (fee.FeeRequest, sdkTypes.Error) {
+
+	msgType := msg.Route() + "-" + msg.Type()
+	signer := msg.GetSigners()[0]
+
+	switch msg := msg.(type) {
+	case bank.MsgMxwSend:
+		return fee.NewFeeRequest(msgType, signer, msg.Amount), nil
+
+	case bank.MsgMxwMultiSend:
+		outputs := make([]sdkTypes.Coins, 0, len(msg.Outputs))
+		for _, out := range msg.Outputs {
+			outputs = append(outputs, out.Coins)
 		}
+		return fee.NewMultiSendFeeRequest(msgType, signer, outputs), nil
 
-		burnAmt := msgType.Value.String() + types.CIN
-		burnAmtCoins, burnAmtCoinsErr := sdkTypes.ParseCoins(burnAmt)
-		if burnAmtCoinsErr != nil {
-			return nil, nil, sdkTypes.ErrUnknownRequest("Parsing value failed.")
+	case token.MsgTransferFungibleToken:
+		amt, err := parseTokenAmount(msg.Value)
+		if err != nil {
+			return fee.FeeRequest{}, err
 		}
+		return fee.NewTokenFeeRequest(msgType, signer, msg.Symbol, fee.TransferFungibleToken, amt), nil
 
-		amt = burnAmtCoins
+	case token.MsgMintFungibleToken:
+		amt, err := parseTokenAmount(msg.Value)
+		if err != nil {
+			return fee.FeeRequest{}, err
+		}
+		return fee.NewTokenFeeRequest(msgType, signer, msg.Symbol, fee.MintFungibleToken, amt), nil
 
-	case token.MsgTransferFungibleTokenOwnership:
-		feeSetting, feeSettingErr = app.feeKeeper.GetTokenFeeSetting(ctx, msgType.Symbol, fee.TransferTokenOwnership)
-		if feeSettingErr != nil {
-			return nil, nil, feeSettingErr
+	case token.MsgBurnFungibleToken:
+		amt, err := parseTokenAmount(msg.Value)
+		if err != nil {
+			return fee.FeeRequest{}, err
 		}
+		return fee.NewTokenFeeRequest(msgType, signer, msg.Symbol, fee.BurnFungibleToken, amt), nil
+
+	case token.MsgTransferFungibleTokenOwnership:
+		return fee.NewTokenFeeRequest(msgType, signer, msg.Symbol, fee.TransferTokenOwnership, nil), nil
 
 	case token.MsgAcceptFungibleTokenOwnership:
-		feeSetting, feeSettingErr = app.feeKeeper.GetTokenFeeSetting(ctx, msgType.Symbol, fee.AcceptTokenOwnership)
-		if feeSettingErr != nil {
-			return nil, nil, feeSettingErr
-		}
+		return fee.NewTokenFeeRequest(msgType, signer, msg.Symbol, fee.AcceptTokenOwnership, nil), nil
+
+	// nonfungible items have no amount, so a percentage fee setting of the token action is charged its min
+	case nonFungible.MsgTransferNonFungibleToken:
+		return fee.NewTokenFeeRequest(msgType, signer, msg.Symbol, fee.TransferNonFungibleItem, nil), nil
+
+	case nonFungible.MsgMintNonFungibleToken:
+		return fee.NewTokenFeeRequest(msgType, signer, msg.Symbol, fee.MintNonFungibleItem, nil), nil
+
+	case nonFungible.MsgBurnNonFungibleToken:
+		return fee.NewTokenFeeRequest(msgType, signer, msg.Symbol, fee.BurnNonFungibleItem, nil), nil
+
+	case nonFungible.MsgTransferNonFungibleTokenOwnership:
+		return fee.NewTokenFeeRequest(msgType, signer, msg.Symbol, fee.TransferNonFungibleTokenOwnership, nil), nil
+
+	case nonFungible.MsgAcceptNonFungibleTokenOwnership:
+		return fee.NewTokenFeeRequest(msgType, signer, msg.Symbol, fee.AcceptNonFungibleTokenOwnership, nil), nil
+
+	case nonFungible.MsgEndorsement:
+		return fee.NewTokenFeeRequest(msgType, signer, msg.Symbol, fee.EndorseNonFungibleItem, nil), nil
+
+	case nonFungible.MsgUpdateItemMetadata:
+		return fee.NewTokenFeeRequest(msgType, signer, msg.Symbol, fee.UpdateNonFungibleItem, nil), nil
+
+	case nonFungible.MsgUpdateNFTMetadata:
+		return fee.NewTokenFeeRequest(msgType, signer, msg.Symbol, fee.UpdateNonFungibleMetadata, nil), nil
+	}
+
+	return fee.NewFeeRequest(msgType, signer, nil), nil
+}
+
+func parseTokenAmount(value sdkTypes.Uint) (sdkTypes.Coins, sdkTypes.Error) {
+	amt, err := sdkTypes.ParseCoins(value.String() + types.CIN)
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownRequest("Parsing value failed.")
 	}
 
-	return feeSetting, amt, nil
+	return amt, nil
 }
@@ -6,8 +6,22 @@ import (
 	"time"
 
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
-	"github.com/stretchr/testify/assert"
+	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
+	sdkBank "github.com/cosmos/cosmos-sdk/x/bank"
+	sdkDist "github.com/cosmos/cosmos-sdk/x/distribution"
+	sdkSupply "github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/maxonrow/maxonrow-go/genesis"
+	"github.com/maxonrow/maxonrow-go/types"
+	"github.com/maxonrow/maxonrow-go/x/bank"
 	"github.com/maxonrow/maxonrow-go/x/fee"
+	"github.com/maxonrow/maxonrow-go/x/kyc"
+	fungible "github.com/maxonrow/maxonrow-go/x/token/fungible"
+	nonFungible "github.com/maxonrow/maxonrow-go/x/token/nonfungible"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 )
 
 func TestFeeCalc(t *testing.T) {
@@ -24,7 +38,7 @@ func TestFeeCalc(t *testing.T) {
 
 	amt1, _ := sdkTypes.ParseCoins("88123455432100000000cin")
 	expectedFee1, _ := sdkTypes.ParseCoins("4369016736367332cin")
-	fee1, err := calculateFee(ctx, feeSetting1, "0.00989125", amt1)
+	fee1, err := fee.CalculateFee(ctx, feeSetting1, "0.00989125", amt1)
 	fmt.Println(fee1)
 	assert.NoError(t, err)
 	assert.Equal(t, fee1, expectedFee1)
@@ -41,7 +55,7 @@ func TestFeeCalc(t *testing.T) {
 
 	amt2, _ := sdkTypes.ParseCoins("11111cin")
 	expectedFee2, _ := sdkTypes.ParseCoins("39cin")
-	fee2, err := calculateFee(ctx, feeSetting2, "0.69", amt2)
+	fee2, err := fee.CalculateFee(ctx, feeSetting2, "0.69", amt2)
 	fmt.Println(fee2)
 	assert.NoError(t, err)
 	assert.Equal(t, fee2, expectedFee2)
@@ -62,7 +76,7 @@ func TestFeeCalcBadPercentage(t *testing.T) {
 	amt1, _ := sdkTypes.ParseCoins("11111111111111111cin")
 	expectedFee1, _ := sdkTypes.ParseCoins("56922222222222cin")
 
-	fee1, err := calculateFee(ctx, feeSetting1, "1", amt1)
+	fee1, err := fee.CalculateFee(ctx, feeSetting1, "1", amt1)
 	fmt.Println(fee1)
 	assert.NoError(t, err)
 	assert.Equal(t, fee1, expectedFee1)
@@ -83,7 +97,7 @@ func TestFeeCalcBadMax(t *testing.T) {
 	amt1, _ := sdkTypes.ParseCoins("11111111111111111cin")
 	expectedFee1, _ := sdkTypes.ParseCoins("100000cin")
 
-	fee1, err := calculateFee(ctx, feeSetting1, "1", amt1)
+	fee1, err := fee.CalculateFee(ctx, feeSetting1, "1", amt1)
 	fmt.Println(fee1)
 	assert.NoError(t, err)
 	assert.Equal(t, fee1, expectedFee1)
@@ -105,11 +119,11 @@ func TestFeeCalc100mxw(t *testing.T) {
 	expectedFee1, _ := sdkTypes.ParseCoins("124316046741000cin")
 	expectedFee2, _ := sdkTypes.ParseCoins("251740000000000000cin")
 
-	fee1, err := calculateFee(ctx, feeSetting1, "0.0009876543", amt1)
+	fee1, err := fee.CalculateFee(ctx, feeSetting1, "0.0009876543", amt1)
 	assert.NoError(t, err)
 	assert.Equal(t, fee1, expectedFee1)
 
-	fee2, err := calculateFee(ctx, feeSetting1, "2", amt1)
+	fee2, err := fee.CalculateFee(ctx, feeSetting1, "2", amt1)
 	assert.NoError(t, err)
 	assert.Equal(t, fee2, expectedFee2)
 }
@@ -130,11 +144,11 @@ func TestFeeCalc1000890000000000cin(t *testing.T) {
 	expectedFee1, _ := sdkTypes.ParseCoins("1244266880cin")
 	expectedFee2, _ := sdkTypes.ParseCoins("2519640486000cin")
 
-	fee1, err := calculateFee(ctx, feeSetting1, "0.0009876543", amt1)
+	fee1, err := fee.CalculateFee(ctx, feeSetting1, "0.0009876543", amt1)
 	assert.NoError(t, err)
 	assert.Equal(t, fee1, expectedFee1)
 
-	fee2, err := calculateFee(ctx, feeSetting1, "2", amt1)
+	fee2, err := fee.CalculateFee(ctx, feeSetting1, "2", amt1)
 	assert.NoError(t, err)
 	assert.Equal(t, fee2, expectedFee2)
 }
@@ -192,3 +206,423 @@ func TestCalculateInt64(t *testing.T) {
 	fmt.Println(fee)
 	fmt.Println(time.Now())
 }
+
+func TestFeeCalcTiered(t *testing.T) {
+	ctx := sdkTypes.Context{}
+	min, _ := sdkTypes.ParseCoins("1cin")
+	threshold1, _ := sdkTypes.ParseCoins("1000cin")
+	threshold2, _ := sdkTypes.ParseCoins("100000cin")
+	flat, _ := sdkTypes.ParseCoins("300cin")
+	feeSetting := &fee.FeeSetting{
+		Name: "tiered",
+		Min:  min,
+		Brackets: []fee.FeeBracket{
+			{Threshold: threshold1, Percentage: "1"},
+			{Threshold: threshold2, Percentage: "0.5"},
+			{Flat: flat},
+		},
+	}
+
+	cases := []struct {
+		amount string
+		fee    string
+	}{
+		{"0cin", "1cin"},
+		{"500cin", "5cin"},
+		{"1000cin", "10cin"},
+		{"20000cin", "100cin"},
+		{"200000cin", "300cin"},
+	}
+
+	for _, c := range cases {
+		amt, _ := sdkTypes.ParseCoins(c.amount)
+		expectedFee, _ := sdkTypes.ParseCoins(c.fee)
+		calculated, err := fee.CalculateFee(ctx, feeSetting, "1", amt)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedFee, calculated, c.amount)
+	}
+}
+
+func TestQuoteFeeEqualsCharged(t *testing.T) {
+	_, _, owner := KeyTestPubAddr()
+	_, _, holder := KeyTestPubAddr()
+
+	zero := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(0)))
+	min := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(100000)))
+	max := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(100000000000)))
+
+	gen := genesis.NewDefaultGenesisState()
+	gen.FeeState.AuthorisedAddresses = []sdkTypes.AccAddress{owner}
+	gen.FeeState.TokenMultiplier = "2"
+	gen.FeeState.FeeSettings = append(gen.FeeState.FeeSettings,
+		fee.GenesisFeeSetting{Name: "zero", Min: zero, Max: zero, Percentage: "0"},
+		fee.GenesisFeeSetting{Name: "kyc", Min: min, Max: min, Percentage: "0"},
+		fee.GenesisFeeSetting{Name: "token", Min: min, Max: max, Percentage: "1.5"},
+	)
+	gen.FeeState.AssignedMsgFeeSettings = []fee.AssignMsgFeeSetting{{Name: "kyc", MsgType: "kyc-whitelist"}}
+	gen.FeeState.AssignedAccFeeSettings = []fee.AssignAccFeeSetting{{Name: "zero", Account: holder}}
+	gen.FeeState.AssignedTokenFeeSettings = []fee.AssignTokenFeeSetting{
+		{Name: "token", Symbol: "TT", Action: fee.TransferFungibleToken},
+		{Name: "token", Symbol: "TNFT", Action: fee.EndorseNonFungibleItem},
+		{Name: "token", Symbol: "TNFT", Action: fee.TransferNonFungibleItem},
+	}
+
+	appState, err := MakeDefaultCodec().MarshalJSON(gen)
+	assert.NoError(t, err)
+
+	app := NewMXWApp(log.NewNopLogger(), dbm.NewMemDB())
+	app.InitChain(abci.RequestInitChain{ChainId: "maxonrow-chain", AppStateBytes: appState})
+	app.Commit()
+	ctx := app.NewContext(true, abci.Header{})
+
+	amt, _ := sdkTypes.ParseCoins("100000000000000000000cin")
+	value := sdkTypes.NewUint(1000000000)
+
+	cases := []struct {
+		name   string
+		msg    sdkTypes.Msg
+		query  string
+		source string
+		fee    string
+	}{
+		{"bank default", bank.NewMsgSend(owner, holder, amt), "bank/get_fee/bank-send/100000000000000000000/" + owner.String(), fee.FeeSourceDefault, "50000000000000000cin"},
+		{"bank account", bank.NewMsgSend(holder, owner, amt), "bank/get_fee/bank-send/100000000000000000000/" + holder.String(), fee.FeeSourceAccount, ""},
+		{"kyc msg", kyc.NewMsgWhitelist(owner, kyc.KycData{}), "kyc/get_fee/kyc-whitelist", fee.FeeSourceMsg, "100000cin"},
+		{"token transfer", *fungible.NewMsgTransferFungibleToken("TT", value, owner, holder), "token/get_fee/TT/transfer/1000000000/" + owner.String(), fee.FeeSourceToken, "30000000cin"},
+		{"token transfer fallback", *fungible.NewMsgTransferFungibleToken("XX", value, owner, holder), "token/get_fee/XX/transfer/1000000000", fee.FeeSourceDefault, "10000000000000000cin"},
+		{"nonfungible endorse", *nonFungible.NewMsgEndorsement("TNFT", owner, "1"), "nonFungible/get_fee/TNFT/endorse", fee.FeeSourceToken, "100000cin"},
+		{"nonfungible transfer", *nonFungible.NewMsgTransferNonFungibleToken("TNFT", owner, holder, "1"), "nonFungible/get_fee/TNFT/transferItem", fee.FeeSourceToken, "100000cin"},
+		{"nonfungible transfer of a fungible symbol", *nonFungible.NewMsgTransferNonFungibleToken("TT", owner, holder, "1"), "nonFungible/get_fee/TT/transferItem", fee.FeeSourceDefault, "10000000000000000cin"},
+		{"nonfungible endorse account", *nonFungible.NewMsgEndorsement("TNFT", holder, "1"), "nonFungible/get_fee/TNFT/endorse/" + holder.String(), fee.FeeSourceAccount, ""},
+	}
+
+	for _, c := range cases {
+		tx := sdkAuth.NewStdTx([]sdkTypes.Msg{c.msg}, sdkAuth.NewStdFee(0, nil), nil, "")
+
+		quotes, quoteErr := app.QuoteFee(ctx, tx)
+		assert.Nil(t, quoteErr, c.name)
+		if !assert.Len(t, quotes, 1, c.name) {
+			continue
+		}
+		assert.Equal(t, c.source, quotes[0].Source, c.name)
+		assert.Equal(t, c.fee, quotes[0].Fee.String(), c.name)
+
+		// the ante handler charges what the fee engine quotes
+		charged, feeErr := app.CalculateFee(ctx, tx)
+		assert.Nil(t, feeErr, c.name)
+		assert.Equal(t, quotes[0].Fee, charged, c.name)
+
+		res := app.Query(abci.RequestQuery{Path: "custom/" + c.query})
+		assert.Equal(t, uint32(0), res.Code, c.name)
+		assert.Equal(t, string(sdkTypes.MustSortJSON(MakeDefaultCodec().MustMarshalJSON(quotes[0].FeeAmount()))), string(res.Value), c.name)
+	}
+}
+
+func TestDistributeFees(t *testing.T) {
+	_, _, payer := KeyTestPubAddr()
+	_, _, treasury := KeyTestPubAddr()
+	_, _, collector1 := KeyTestPubAddr()
+	_, _, collector2 := KeyTestPubAddr()
+
+	gen := genesis.NewDefaultGenesisState()
+	acc := sdkAuth.NewBaseAccountWithAddress(payer)
+	acc.Coins = sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000000)))
+	gen.Accounts = append(gen.Accounts, &acc)
+	gen.FeeState.AuthorisedAddresses = []sdkTypes.AccAddress{payer}
+	gen.FeeState.FeeCollectors = []fee.GenesisFeeCollector{{Module: "token", Addresses: []sdkTypes.AccAddress{collector1, collector2}}}
+	gen.FeeState.FeeDistribution = &fee.FeeDistribution{
+		Validators:      "40",
+		Treasury:        "20",
+		TreasuryAddress: treasury,
+		Burn:            "10",
+		Collectors:      []fee.ModuleFeeShare{{Module: "token", Percentage: "30"}},
+	}
+
+	appState, err := MakeDefaultCodec().MarshalJSON(gen)
+	assert.NoError(t, err)
+
+	app := NewMXWApp(log.NewNopLogger(), dbm.NewMemDB())
+	app.InitChain(abci.RequestInitChain{ChainId: "maxonrow-chain", AppStateBytes: appState})
+	app.Commit()
+	ctx := app.NewContext(true, abci.Header{Height: 2})
+
+	fees := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1001)))
+	assert.Nil(t, app.supplyKeeper.SendCoinsFromAccountToModule(ctx, payer, sdkAuth.FeeCollectorName, fees))
+	supplyBefore := app.supplyKeeper.GetSupply(ctx).GetTotal()
+
+	events, distributeErr := app.feeKeeper.DistributeFees(ctx, app.feeSupplyKeeper())
+	assert.Nil(t, distributeErr)
+	assert.Len(t, events, 3)
+
+	assert.Equal(t, "200cin", app.accountKeeper.GetAccount(ctx, treasury).GetCoins().String())
+	assert.Equal(t, "150cin", app.accountKeeper.GetAccount(ctx, collector1).GetCoins().String())
+	assert.Equal(t, "150cin", app.accountKeeper.GetAccount(ctx, collector2).GetCoins().String())
+	assert.Equal(t, "401cin", app.supplyKeeper.GetModuleAccount(ctx, sdkAuth.FeeCollectorName).GetCoins().String())
+	assert.Equal(t, supplyBefore.Sub(sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(100)))), app.supplyKeeper.GetSupply(ctx).GetTotal())
+
+	last, lastErr := app.feeKeeper.GetLastFeeDistribution(ctx)
+	assert.Nil(t, lastErr)
+	assert.Equal(t, int64(2), last.Height)
+	assert.Equal(t, "401cin", last.Validators.String())
+	assert.Equal(t, "100cin", last.Burned.String())
+	assert.Len(t, last.Collectors, 2)
+
+	// the distribution module takes the validators share at the next begin block
+	validatorsShare := app.supplyKeeper.GetModuleAccount(ctx, sdkAuth.FeeCollectorName).GetCoins()
+	assert.Nil(t, app.supplyKeeper.SendCoinsFromModuleToModule(ctx, sdkAuth.FeeCollectorName, sdkDist.ModuleName, validatorsShare))
+	assert.Nil(t, app.supplyKeeper.SendCoinsFromAccountToModule(ctx, payer, sdkAuth.FeeCollectorName, fees))
+	_, distributeErr = app.feeKeeper.DistributeFees(ctx, app.feeSupplyKeeper())
+	assert.Nil(t, distributeErr)
+
+	total, totalErr := app.feeKeeper.GetTotalFeeDistribution(ctx)
+	assert.Nil(t, totalErr)
+	assert.Equal(t, "200cin", total.Burned.String())
+	assert.Len(t, total.Collectors, 2)
+	assert.Equal(t, "300cin", total.Collectors[0].Amount.String())
+}
+
+func TestDistributeFeesWithoutSupply(t *testing.T) {
+	_, _, payer := KeyTestPubAddr()
+	_, _, treasury := KeyTestPubAddr()
+
+	gen := genesis.NewDefaultGenesisState()
+	acc := sdkAuth.NewBaseAccountWithAddress(payer)
+	acc.Coins = sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000000)))
+	gen.Accounts = append(gen.Accounts, &acc)
+	gen.FeeState.AuthorisedAddresses = []sdkTypes.AccAddress{payer}
+	gen.FeeState.FeeDistribution = &fee.FeeDistribution{
+		Validators:      "70",
+		Treasury:        "20",
+		TreasuryAddress: treasury,
+		Burn:            "10",
+	}
+
+	appState, err := MakeDefaultCodec().MarshalJSON(gen)
+	assert.NoError(t, err)
+
+	app := NewMXWApp(log.NewNopLogger(), dbm.NewMemDB())
+	app.InitChain(abci.RequestInitChain{ChainId: "maxonrow-chain", AppStateBytes: appState})
+	app.Commit()
+	ctx := app.NewContext(true, abci.Header{Height: 2})
+
+	// the chains started before the supply was tracked have none
+	ctx.KVStore(app.keySupply).Delete(sdkSupply.SupplyKey)
+	fees := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000)))
+	assert.Nil(t, app.supplyKeeper.SendCoinsFromAccountToModule(ctx, payer, sdkAuth.FeeCollectorName, fees))
+
+	_, distributeErr := app.feeKeeper.DistributeFees(ctx, app.feeSupplyKeeper())
+	assert.NotNil(t, distributeErr)
+	assert.Nil(t, app.accountKeeper.GetAccount(ctx, treasury))
+	assert.Equal(t, fees, app.supplyKeeper.GetModuleAccount(ctx, sdkAuth.FeeCollectorName).GetCoins())
+	assert.NotPanics(t, func() { app.endBlocker(ctx, abci.RequestEndBlock{Height: 2}) })
+
+	// the begin block stores the supply from the coins of the accounts
+	app.migrateSupply(ctx)
+	assert.Equal(t, "1000000cin", app.supplyKeeper.GetSupply(ctx).GetTotal().String())
+
+	_, distributeErr = app.feeKeeper.DistributeFees(ctx, app.feeSupplyKeeper())
+	assert.Nil(t, distributeErr)
+	assert.Equal(t, "200cin", app.accountKeeper.GetAccount(ctx, treasury).GetCoins().String())
+	assert.Equal(t, "999900cin", app.supplyKeeper.GetSupply(ctx).GetTotal().String())
+}
+
+func TestPayFeeInFungibleToken(t *testing.T) {
+	priv, _, payer := KeyTestPubAddr()
+	_, _, receiver := KeyTestPubAddr()
+	_, _, collector := KeyTestPubAddr()
+
+	gen := genesis.NewDefaultGenesisState()
+	acc := sdkAuth.NewBaseAccountWithAddress(payer)
+	acc.Coins = sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000000)))
+	gen.Accounts = append(gen.Accounts, &acc)
+	gen.FeeState.AuthorisedAddresses = []sdkTypes.AccAddress{payer}
+	gen.FeeState.FeeTokens = []fee.FeeToken{fee.NewFeeToken("TT", "0.000000000001", collector)}
+	gen.TokenState.Tokens = []fungible.Token{{
+		Flags:       fungible.DynamicFungibleTokenMask + fungible.ApprovedFlag,
+		Name:        "Test Token",
+		Symbol:      "TT",
+		Decimals:    8,
+		Owner:       payer,
+		TotalSupply: sdkTypes.NewUint(1000000),
+		MaxSupply:   sdkTypes.NewUint(0),
+	}}
+	gen.TokenState.TokenAccounts = []fungible.GenesisTokenAccount{
+		{Symbol: "TT", Account: fungible.FungibleTokenAccount{Owner: payer, Balance: sdkTypes.NewUint(1000000)}},
+	}
+
+	appState, err := MakeDefaultCodec().MarshalJSON(gen)
+	assert.NoError(t, err)
+
+	app := NewMXWApp(log.NewNopLogger(), dbm.NewMemDB())
+	app.InitChain(abci.RequestInitChain{ChainId: "maxonrow-chain", AppStateBytes: appState})
+	app.Commit()
+	ctx := app.NewContext(true, abci.Header{ChainID: "maxonrow-chain", Height: 2})
+
+	makeTx := func(fees sdkTypes.Coins) sdkAuth.StdTx {
+		msgs := []sdkTypes.Msg{bank.NewMsgSend(payer, receiver, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000))))}
+		return signTx(t, app, ctx, priv, msgs, fees)
+	}
+
+	// the default fee setting charges the minimum fee of 10000000000000000cin
+	tokenFee := sdkTypes.Coins{sdkTypes.Coin{Denom: "TT", Amount: sdkTypes.NewInt(10000)}}
+	calculated, calcErr := app.CalculateFee(ctx, makeTx(tokenFee))
+	assert.Nil(t, calcErr)
+	assert.Equal(t, tokenFee, calculated)
+
+	details, detailsErr := app.QueryFeeDetails(nil, string(app.cdc.MustMarshalJSON(makeTx(tokenFee))))
+	assert.NoError(t, detailsErr)
+	assert.Equal(t, tokenFee, details.Fee.Amount)
+	assert.Equal(t, "TT", details.FeeToken.Symbol)
+
+	anteHandler := app.NewAnteHandler()
+
+	underpaid := sdkTypes.Coins{sdkTypes.Coin{Denom: "TT", Amount: sdkTypes.NewInt(9999)}}
+	_, anteErr := anteHandler(ctx, makeTx(underpaid), false)
+	assert.Error(t, anteErr)
+
+	unknown := sdkTypes.Coins{sdkTypes.Coin{Denom: "XX", Amount: sdkTypes.NewInt(10000)}}
+	_, anteErr = anteHandler(ctx, makeTx(unknown), false)
+	assert.Error(t, anteErr)
+
+	_, anteErr = anteHandler(ctx, makeTx(tokenFee), false)
+	assert.NoError(t, anteErr)
+
+	payerAccount, _ := app.tokenKeeper.GetAccount(ctx, "TT", payer)
+	collectorAccount, _ := app.tokenKeeper.GetAccount(ctx, "TT", collector)
+	assert.Equal(t, sdkTypes.NewUint(990000), payerAccount.(*fungible.FungibleTokenAccount).Balance)
+	assert.Equal(t, sdkTypes.NewUint(10000), collectorAccount.(*fungible.FungibleTokenAccount).Balance)
+	assert.Equal(t, "1000000cin", app.accountKeeper.GetAccount(ctx, payer).GetCoins().String())
+}
+
+func signTx(t *testing.T, app *mxwApp, ctx sdkTypes.Context, priv crypto.PrivKey, msgs []sdkTypes.Msg, fees sdkTypes.Coins) sdkAuth.StdTx {
+	return signTxWithMemo(t, app, ctx, priv, msgs, fees, "")
+}
+
+func signTxWithMemo(t *testing.T, app *mxwApp, ctx sdkTypes.Context, priv crypto.PrivKey, msgs []sdkTypes.Msg, fees sdkTypes.Coins, memo string) sdkAuth.StdTx {
+	stdFee := sdkAuth.NewStdFee(0, fees)
+	signer := app.accountKeeper.GetAccount(ctx, sdkTypes.AccAddress(priv.PubKey().Address()))
+	signBytes := sdkAuth.StdSignBytes(ctx.ChainID(), signer.GetAccountNumber(), signer.GetSequence(), stdFee, msgs, memo)
+	sig, err := priv.Sign(signBytes)
+	assert.NoError(t, err)
+
+	return sdkAuth.NewStdTx(msgs, stdFee, []sdkAuth.StdSignature{{PubKey: priv.PubKey(), Signature: sig}}, memo)
+}
+
+func TestPayFeeWithAllowance(t *testing.T) {
+	_, _, granter := KeyTestPubAddr()
+	priv, _, grantee := KeyTestPubAddr()
+	_, _, receiver := KeyTestPubAddr()
+
+	gen := genesis.NewDefaultGenesisState()
+	for _, addr := range []sdkTypes.AccAddress{granter, grantee} {
+		acc := sdkAuth.NewBaseAccountWithAddress(addr)
+		acc.Coins = sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(100000000000000000)))
+		gen.Accounts = append(gen.Accounts, &acc)
+	}
+	gen.FeeState.AuthorisedAddresses = []sdkTypes.AccAddress{granter}
+	gen.FeeState.FeeAllowances = []fee.FeeAllowance{{
+		Granter:    granter,
+		Grantee:    grantee,
+		SpendLimit: sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(20000000000000000))),
+		PerTxLimit: sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(10000000000000000))),
+		Expiration: time.Unix(1900000000, 0).UTC(),
+		MsgTypes:   []string{"bank-send"},
+	}}
+
+	appState, err := MakeDefaultCodec().MarshalJSON(gen)
+	assert.NoError(t, err)
+
+	app := NewMXWApp(log.NewNopLogger(), dbm.NewMemDB())
+	app.InitChain(abci.RequestInitChain{ChainId: "maxonrow-chain", AppStateBytes: appState})
+	app.Commit()
+	ctx := app.NewContext(true, abci.Header{ChainID: "maxonrow-chain", Height: 2, Time: time.Unix(1800000000, 0).UTC()})
+	anteHandler := app.NewAnteHandler()
+
+	// the default fee setting charges the minimum fee of 10000000000000000cin
+	minFee := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(10000000000000000)))
+	send := bank.NewMsgSend(grantee, receiver, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000))))
+	useAllowance := fee.NewMsgUseFeeAllowance(granter, grantee)
+
+	// naming the granter is not charged
+	calculated, calcErr := app.CalculateFee(ctx, signTx(t, app, ctx, priv, []sdkTypes.Msg{send, useAllowance}, minFee))
+	assert.Nil(t, calcErr)
+	assert.Equal(t, minFee, calculated)
+
+	// above the per tx limit
+	doubleFee := minFee.Add(minFee)
+	_, anteErr := anteHandler(ctx, signTx(t, app, ctx, priv, []sdkTypes.Msg{send, useAllowance}, doubleFee), false)
+	assert.Error(t, anteErr)
+
+	// msg type not allowed
+	revoke := fee.NewMsgRevokeFeeAllowance(grantee, granter)
+	_, anteErr = anteHandler(ctx, signTx(t, app, ctx, priv, []sdkTypes.Msg{revoke, useAllowance}, minFee), false)
+	assert.Error(t, anteErr)
+
+	for i := 0; i < 2; i++ {
+		_, anteErr = anteHandler(ctx, signTx(t, app, ctx, priv, []sdkTypes.Msg{send, useAllowance}, minFee), false)
+		assert.NoError(t, anteErr)
+	}
+
+	assert.Equal(t, "80000000000000000cin", app.accountKeeper.GetAccount(ctx, granter).GetCoins().String())
+	assert.Equal(t, "100000000000000000cin", app.accountKeeper.GetAccount(ctx, grantee).GetCoins().String())
+
+	// the spend limit is used up
+	_, allowanceErr := app.feeKeeper.GetFeeAllowance(ctx, granter, grantee)
+	assert.NotNil(t, allowanceErr)
+	_, anteErr = anteHandler(ctx, signTx(t, app, ctx, priv, []sdkTypes.Msg{send, useAllowance}, minFee), false)
+	assert.Error(t, anteErr)
+
+	// expired allowance
+	grant := fee.NewMsgGrantFeeAllowance(granter, grantee, nil, nil, time.Unix(1700000000, 0).UTC(), nil)
+	assert.False(t, app.feeKeeper.GrantFeeAllowance(ctx, grant).IsOK())
+}
+
+func TestMultiSend(t *testing.T) {
+	priv, _, sender := KeyTestPubAddr()
+
+	gen := genesis.NewDefaultGenesisState()
+	acc := sdkAuth.NewBaseAccountWithAddress(sender)
+	acc.Coins = sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(100000000000000000)))
+	gen.Accounts = append(gen.Accounts, &acc)
+	gen.FeeState.AuthorisedAddresses = []sdkTypes.AccAddress{sender}
+
+	appState, err := MakeDefaultCodec().MarshalJSON(gen)
+	assert.NoError(t, err)
+
+	app := NewMXWApp(log.NewNopLogger(), dbm.NewMemDB())
+	app.InitChain(abci.RequestInitChain{ChainId: "maxonrow-chain", AppStateBytes: appState})
+	app.Commit()
+	ctx := app.NewContext(true, abci.Header{ChainID: "maxonrow-chain", Height: 2})
+
+	var receivers []sdkTypes.AccAddress
+	var bankOutputs []sdkBank.Output
+	for i := 0; i < 3; i++ {
+		_, _, receiver := KeyTestPubAddr()
+		receivers = append(receivers, receiver)
+		bankOutputs = append(bankOutputs, sdkBank.NewOutput(receiver, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(int64(1000*(i+1)))))))
+	}
+	multiSend := bank.NewMsgMultiSend(sender, bankOutputs)
+	assert.Nil(t, multiSend.ValidateBasic())
+	assert.Equal(t, "6000cin", multiSend.Total().String())
+
+	// every output pays the minimum fee of the default fee setting
+	minFee := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(10000000000000000)))
+	calculated, calcErr := app.CalculateFee(ctx, signTx(t, app, ctx, priv, []sdkTypes.Msg{multiSend}, minFee))
+	assert.Nil(t, calcErr)
+	assert.Equal(t, "30000000000000000cin", calculated.String())
+
+	result := bank.NewHandler(app.bankKeeper, app.accountKeeper, &app.kycKeeper)(ctx, multiSend)
+	assert.True(t, result.IsOK())
+
+	transferred := 0
+	for _, event := range result.Events {
+		if event.Type == types.SYSTEM {
+			transferred++
+		}
+	}
+	assert.Equal(t, 3, transferred)
+
+	for i, receiver := range receivers {
+		assert.Equal(t, bankOutputs[i].Coins, app.accountKeeper.GetAccount(ctx, receiver).GetCoins())
+	}
+}
@@ -0,0 +1,451 @@
+package app
+
+import (
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	sdkBank "github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/maxonrow/maxonrow-go/genesis"
+	"github.com/maxonrow/maxonrow-go/types"
+	"github.com/maxonrow/maxonrow-go/x/bank"
+	"github.com/maxonrow/maxonrow-go/x/kyc"
+	"github.com/maxonrow/maxonrow-go/x/maintenance"
+	"github.com/maxonrow/maxonrow-go/x/token/fungible"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type kycTestKeys struct {
+	authorised sdkTypes.AccAddress
+	issuer     crypto.PrivKey
+	provider   crypto.PrivKey
+}
+
+// setupKyc returns an app with an authorised address, an issuer and a provider of kyc.
+func setupKyc(t *testing.T) (*mxwApp, sdkTypes.Context, sdkTypes.Handler, kycTestKeys) {
+	_, _, authorised := KeyTestPubAddr()
+	issuer, _, issuerAddr := KeyTestPubAddr()
+	provider, _, providerAddr := KeyTestPubAddr()
+
+	gen := genesis.NewDefaultGenesisState()
+	gen.KycState.AuthorizedAddresses = []sdkTypes.AccAddress{authorised}
+	gen.KycState.IssuerAddresses = []sdkTypes.AccAddress{issuerAddr}
+	gen.KycState.ProviderAddresses = []sdkTypes.AccAddress{providerAddr}
+	gen.FeeState.AuthorisedAddresses = []sdkTypes.AccAddress{authorised}
+	issuerAcc := sdkAuth.NewBaseAccountWithAddress(issuerAddr)
+	gen.Accounts = append(gen.Accounts, &issuerAcc)
+
+	appState, err := MakeDefaultCodec().MarshalJSON(gen)
+	assert.NoError(t, err)
+
+	app := NewMXWApp(log.NewNopLogger(), dbm.NewMemDB())
+	app.InitChain(abci.RequestInitChain{ChainId: "maxonrow-chain", AppStateBytes: appState})
+	app.Commit()
+	ctx := app.NewContext(true, abci.Header{ChainID: "maxonrow-chain", Height: 2, Time: time.Unix(1600000000, 0)})
+
+	return app, ctx, kyc.NewHandler(&app.kycKeeper), kycTestKeys{authorised, issuer, provider}
+}
+
+func makeMsgWhitelist(t *testing.T, keys kycTestKeys, from crypto.PrivKey, kycAddress string, expiry kyc.WhitelistExpiry) kyc.MsgWhitelist {
+	return makeMsgWhitelistWithTier(t, keys, from, kycAddress, expiry, "")
+}
+
+func makeMsgWhitelistWithTier(t *testing.T, keys kycTestKeys, from crypto.PrivKey, kycAddress string, expiry kyc.WhitelistExpiry, tier string) kyc.MsgWhitelist {
+	kycInfo := kyc.NewKyc(sdkTypes.AccAddress(from.PubKey().Address()), "0", kycAddress)
+	kycInfo.ExpiryHeight = expiry.ExpiryHeight
+	kycInfo.ExpiryTime = expiry.ExpiryTime
+	kycInfo.Tier = tier
+
+	fromSig, err := from.Sign(kycInfo.GetFromSignBytes())
+	assert.NoError(t, err)
+	payload := kyc.NewPayload(kycInfo, from.PubKey(), fromSig)
+
+	var signatures []kyc.Signature
+	for _, signer := range []crypto.PrivKey{keys.provider, keys.issuer} {
+		sig, err := signer.Sign(payload.GetIssuerSignBytes())
+		assert.NoError(t, err)
+		signatures = append(signatures, kyc.NewSignature(signer.PubKey(), sig))
+	}
+
+	return kyc.NewMsgWhitelist(keys.authorised, kyc.NewKycData(payload, signatures))
+}
+
+func makeMsgRenewWhitelist(t *testing.T, keys kycTestKeys, signer crypto.PrivKey, payload kyc.RenewPayload) kyc.MsgRenewWhitelist {
+	sig, err := signer.Sign(payload.GetRenewIssuerSignBytes())
+	assert.NoError(t, err)
+
+	return kyc.NewMsgRenewWhitelist(keys.authorised, payload, []kyc.Signature{kyc.NewSignature(signer.PubKey(), sig)})
+}
+
+// makeMsgKycBind returns the bind signed by the wallet, with its sequence as the nonce.
+func makeMsgKycBind(t *testing.T, app *mxwApp, ctx sdkTypes.Context, from sdkTypes.AccAddress, to crypto.PrivKey, kycAddress string) kyc.MsgKycBind {
+	toAddr := sdkTypes.AccAddress(to.PubKey().Address())
+	nonce := "0"
+	if acc := app.accountKeeper.GetAccount(ctx, toAddr); acc != nil {
+		nonce = strconv.FormatUint(acc.GetSequence(), 10)
+	}
+
+	msg := kyc.NewMsgKycBind(from, toAddr, kycAddress, nonce)
+	sig, err := to.Sign(msg.GetToSignBytes())
+	assert.NoError(t, err)
+	msg.Signature = kyc.NewSignature(to.PubKey(), sig)
+
+	return msg
+}
+
+func TestKycWhitelistExpiry(t *testing.T) {
+	app, ctx, handler, keys := setupKyc(t)
+	querier := kyc.NewQuerier(&app.kycKeeper, &app.feeKeeper)
+	user, _, userAddr := KeyTestPubAddr()
+	other, _, otherAddr := KeyTestPubAddr()
+
+	assert.False(t, handler(ctx, makeMsgWhitelist(t, keys, user, "kyc-user", kyc.WhitelistExpiry{ExpiryHeight: 2})).IsOK())
+	assert.True(t, handler(ctx, makeMsgWhitelist(t, keys, user, "kyc-user", kyc.WhitelistExpiry{ExpiryHeight: 5})).IsOK())
+	assert.True(t, handler(ctx, makeMsgWhitelist(t, keys, other, "kyc-other", kyc.WhitelistExpiry{ExpiryTime: 1600000100})).IsOK())
+	assert.Equal(t, int64(5), app.kycKeeper.GetWhitelistExpiry(ctx, userAddr).ExpiryHeight)
+
+	// renewing needs an issuer signature of the kyc address the account is whitelisted with
+	assert.False(t, handler(ctx, makeMsgRenewWhitelist(t, keys, keys.provider, kyc.NewRenewPayload(userAddr, "0", "kyc-user", 10, 0))).IsOK())
+	assert.False(t, handler(ctx, makeMsgRenewWhitelist(t, keys, keys.issuer, kyc.NewRenewPayload(userAddr, "0", "kyc-other", 10, 0))).IsOK())
+	renew := makeMsgRenewWhitelist(t, keys, keys.issuer, kyc.NewRenewPayload(userAddr, "0", "kyc-user", 10, 0))
+	result := handler(ctx, renew)
+	assert.True(t, result.IsOK())
+	assert.Equal(t, "kyc-user", string(app.kycKeeper.GetKycAddress(ctx, userAddr)))
+
+	// the issuer sequence is the nonce of the renewal, it can't be replayed
+	issuerAddr := sdkTypes.AccAddress(keys.issuer.PubKey().Address())
+	assert.Equal(t, uint64(1), app.accountKeeper.GetAccount(ctx, issuerAddr).GetSequence())
+	assert.Equal(t, sdkTypes.CodeInvalidSequence, handler(ctx, renew).Code)
+	assert.True(t, handler(ctx, makeMsgRenewWhitelist(t, keys, keys.issuer, kyc.NewRenewPayload(userAddr, "1", "kyc-user", 10, 0))).IsOK())
+
+	// a renewal is signed by one issuer, its nonce is the sequence of that issuer only
+	twice := makeMsgRenewWhitelist(t, keys, keys.issuer, kyc.NewRenewPayload(userAddr, "2", "kyc-user", 10, 0))
+	twice.Signatures = append(twice.Signatures, twice.Signatures[0])
+	assert.NotNil(t, twice.ValidateBasic())
+	assert.Equal(t, sdkTypes.CodeUnauthorized, handler(ctx, twice).Code)
+	assert.Equal(t, uint64(2), app.accountKeeper.GetAccount(ctx, issuerAddr).GetSequence())
+
+	bz, err := querier(ctx, []string{kyc.QueryGetWhitelistExpiry, userAddr.String()}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	var expiry kyc.WhitelistExpiry
+	app.cdc.MustUnmarshalJSON(bz, &expiry)
+	assert.Equal(t, kyc.WhitelistExpiry{ExpiryHeight: 10}, expiry)
+
+	// nothing expires before its height or time
+	assert.Empty(t, app.kycKeeper.RevokeExpiredWhitelists(ctx.WithBlockHeight(9)))
+	assert.True(t, app.kycKeeper.IsWhitelisted(ctx, userAddr))
+
+	events := app.kycKeeper.RevokeExpiredWhitelists(ctx.WithBlockHeight(10))
+	assert.Len(t, events, 1)
+	assert.False(t, app.kycKeeper.IsWhitelisted(ctx, userAddr))
+	assert.False(t, app.kycKeeper.IsKycAddressExist(ctx, "kyc-user"))
+	assert.True(t, app.kycKeeper.GetWhitelistExpiry(ctx, userAddr).IsEmpty())
+	assert.True(t, app.kycKeeper.IsWhitelisted(ctx, otherAddr))
+
+	timeCtx := ctx.WithBlockHeight(11).WithBlockHeader(abci.Header{Height: 11, Time: time.Unix(1600000100, 0)})
+	assert.Len(t, app.kycKeeper.RevokeExpiredWhitelists(timeCtx), 1)
+	assert.False(t, app.kycKeeper.IsWhitelisted(ctx, otherAddr))
+
+	// a revoked account can't be renewed
+	assert.False(t, handler(ctx, makeMsgRenewWhitelist(t, keys, keys.issuer, kyc.NewRenewPayload(userAddr, "2", "kyc-user", 20, 0))).IsOK())
+}
+
+func TestKycRenewWithoutIssuerAccount(t *testing.T) {
+	app, ctx, handler, keys := setupKyc(t)
+	user, _, userAddr := KeyTestPubAddr()
+	issuer, _, issuerAddr := KeyTestPubAddr()
+
+	assert.True(t, handler(ctx, makeMsgWhitelist(t, keys, user, "kyc-user", kyc.WhitelistExpiry{ExpiryHeight: 5})).IsOK())
+	app.kycKeeper.SetIssuerAddresses(ctx, []sdkTypes.AccAddress{issuerAddr})
+
+	result := handler(ctx, makeMsgRenewWhitelist(t, keys, issuer, kyc.NewRenewPayload(userAddr, "0", "kyc-user", 10, 0)))
+	assert.Equal(t, sdkTypes.CodeUnknownAddress, result.Code)
+	assert.Equal(t, int64(5), app.kycKeeper.GetWhitelistExpiry(ctx, userAddr).ExpiryHeight)
+}
+
+func TestKycTierTransferLimits(t *testing.T) {
+	app, ctx, handler, keys := setupKyc(t)
+	querier := kyc.NewQuerier(&app.kycKeeper, &app.feeKeeper)
+	user, _, userAddr := KeyTestPubAddr()
+	_, _, toAddr := KeyTestPubAddr()
+
+	assert.True(t, handler(ctx, makeMsgWhitelistWithTier(t, keys, user, "kyc-user", kyc.WhitelistExpiry{}, "basic")).IsOK())
+	assert.Equal(t, "basic", app.kycKeeper.GetKycTier(ctx, userAddr))
+
+	assert.Nil(t, app.executeKycTierLimitProposal(ctx, maintenance.NewKycTierLimitMaintainer("basic", []maintenance.KycTierTransferLimit{
+		{Denom: types.CIN, Amount: "100"},
+	})))
+
+	app.accountKeeper.SetAccount(ctx, newAccountWithCoins(ctx, app, userAddr, 10000))
+	bankHandler := bank.NewHandler(app.bankKeeper, app.accountKeeper, &app.kycKeeper)
+	send := func(ctx sdkTypes.Context, amount int64) sdkTypes.Result {
+		return deliverMsg(ctx, bankHandler, bank.NewMsgSend(userAddr, toAddr, sdkTypes.NewCoins(sdkTypes.NewInt64Coin(types.CIN, amount))))
+	}
+
+	assert.True(t, send(ctx, 60).IsOK())
+	result := send(ctx, 60)
+	assert.Equal(t, types.CodeKycTransferLimitExceeded, result.Code)
+
+	// tokens without a limit of the tier are unlimited
+	assert.Nil(t, app.kycKeeper.ConsumeTransferLimit(ctx, userAddr, "TT", sdkTypes.NewUint(1000)))
+
+	bz, queryErr := querier(ctx, []string{kyc.QueryGetKycTier, userAddr.String()}, abci.RequestQuery{})
+	assert.Nil(t, queryErr)
+	var info kyc.KycTierInfo
+	app.cdc.MustUnmarshalJSON(bz, &info)
+	assert.Equal(t, "basic", info.Tier)
+	assert.Equal(t, []kyc.TransferLimit{{Denom: types.CIN, Amount: sdkTypes.NewUint(60)}}, info.Transferred)
+
+	// the limit is of a rolling day
+	nextDay := ctx.WithBlockHeader(abci.Header{Height: 3, Time: time.Unix(1600000000+kyc.TransferLimitPeriod, 0)})
+	assert.True(t, send(nextDay, 60).IsOK())
+
+	// only an authorised address can upgrade the tier
+	assert.False(t, handler(ctx, kyc.NewMsgUpgradeKycTier(toAddr, userAddr, "premium")).IsOK())
+	assert.True(t, handler(ctx, kyc.NewMsgUpgradeKycTier(keys.authorised, userAddr, "premium")).IsOK())
+	assert.True(t, send(ctx, 1000).IsOK())
+
+	// revoking the whitelist removes the tier
+	app.kycKeeper.RevokeWhitelist(ctx, userAddr, keys.authorised)
+	assert.Equal(t, "", app.kycKeeper.GetKycTier(ctx, userAddr))
+}
+
+func TestKycTierTransferLimitsOfBoundWallet(t *testing.T) {
+	app, ctx, handler, keys := setupKyc(t)
+	querier := kyc.NewQuerier(&app.kycKeeper, &app.feeKeeper)
+	user, _, userAddr := KeyTestPubAddr()
+	wallet, _, walletAddr := KeyTestPubAddr()
+	_, _, toAddr := KeyTestPubAddr()
+
+	assert.True(t, handler(ctx, makeMsgWhitelistWithTier(t, keys, user, "kyc-user", kyc.WhitelistExpiry{}, "basic")).IsOK())
+	assert.Nil(t, app.executeKycTierLimitProposal(ctx, maintenance.NewKycTierLimitMaintainer("basic", []maintenance.KycTierTransferLimit{
+		{Denom: types.CIN, Amount: "100"},
+	})))
+	assert.True(t, handler(ctx, makeMsgKycBind(t, app, ctx, userAddr, wallet, "kyc-user")).IsOK())
+
+	bankHandler := bank.NewHandler(app.bankKeeper, app.accountKeeper, &app.kycKeeper)
+	send := func(from sdkTypes.AccAddress, amount int64) sdkTypes.Result {
+		return deliverMsg(ctx, bankHandler, bank.NewMsgSend(from, toAddr, sdkTypes.NewCoins(sdkTypes.NewInt64Coin(types.CIN, amount))))
+	}
+	for _, addr := range []sdkTypes.AccAddress{userAddr, walletAddr} {
+		app.accountKeeper.SetAccount(ctx, newAccountWithCoins(ctx, app, addr, 1000))
+	}
+
+	// the bound wallet has the tier of the kyc address, and shares its daily usage
+	assert.Equal(t, types.CodeKycTransferLimitExceeded, send(walletAddr, 120).Code)
+
+	assert.True(t, send(userAddr, 60).IsOK())
+	assert.Equal(t, types.CodeKycTransferLimitExceeded, send(walletAddr, 60).Code)
+	assert.True(t, send(walletAddr, 40).IsOK())
+	assert.False(t, send(userAddr, 1).IsOK())
+
+	bz, queryErr := querier(ctx, []string{kyc.QueryGetKycTier, walletAddr.String()}, abci.RequestQuery{})
+	assert.Nil(t, queryErr)
+	var info kyc.KycTierInfo
+	app.cdc.MustUnmarshalJSON(bz, &info)
+	assert.Equal(t, "basic", info.Tier)
+	assert.Equal(t, []kyc.TransferLimit{{Denom: types.CIN, Amount: sdkTypes.NewUint(100)}}, info.Transferred)
+}
+
+func TestKycTierTransferLimitsOfFailedTransfer(t *testing.T) {
+	app, ctx, handler, keys := setupKyc(t)
+	user, _, userAddr := KeyTestPubAddr()
+	_, _, toAddr := KeyTestPubAddr()
+
+	assert.True(t, handler(ctx, makeMsgWhitelistWithTier(t, keys, user, "kyc-user", kyc.WhitelistExpiry{}, "basic")).IsOK())
+	assert.Nil(t, app.executeKycTierLimitProposal(ctx, maintenance.NewKycTierLimitMaintainer("basic", []maintenance.KycTierTransferLimit{
+		{Denom: types.CIN, Amount: "100"},
+		{Denom: "TT", Amount: "100"},
+	})))
+	app.accountKeeper.SetAccount(ctx, newAccountWithCoins(ctx, app, userAddr, 50))
+
+	msgs := []sdkTypes.Msg{
+		bank.NewMsgSend(userAddr, toAddr, sdkTypes.NewCoins(sdkTypes.NewInt64Coin(types.CIN, 60))),
+		bank.NewMsgMultiSend(userAddr, []sdkBank.Output{sdkBank.NewOutput(toAddr, sdkTypes.NewCoins(sdkTypes.NewInt64Coin(types.CIN, 60)))}),
+		*fungible.NewMsgTransferFungibleToken("TT", sdkTypes.NewUint(60), userAddr, toAddr),
+	}
+
+	// the transfers are within the limits, the ante handler only checks them
+	assert.Nil(t, app.CheckTransferLimits(ctx, sdkAuth.StdTx{Msgs: msgs}))
+
+	// the sender can't afford the transfers, they fail in the handlers and don't count towards the limits
+	bankHandler := bank.NewHandler(app.bankKeeper, app.accountKeeper, &app.kycKeeper)
+	tokenHandler := fungible.NewHandler(&app.tokenKeeper, &app.kycKeeper)
+	assert.False(t, deliverMsg(ctx, bankHandler, msgs[0]).IsOK())
+	assert.False(t, deliverMsg(ctx, bankHandler, msgs[1]).IsOK())
+	assert.False(t, deliverMsg(ctx, tokenHandler, msgs[2]).IsOK())
+
+	info := app.kycKeeper.GetKycTierInfo(ctx, userAddr)
+	assert.Equal(t, []kyc.TransferLimit{
+		{Denom: types.CIN, Amount: sdkTypes.ZeroUint()},
+		{Denom: "TT", Amount: sdkTypes.ZeroUint()},
+	}, info.Transferred)
+	assert.Equal(t, "50cin", app.accountKeeper.GetAccount(ctx, userAddr).GetCoins().String())
+}
+
+// deliverMsg runs the msg in a cache context that is written only if the msg succeeds, as the base app does.
+func deliverMsg(ctx sdkTypes.Context, handler sdkTypes.Handler, msg sdkTypes.Msg) sdkTypes.Result {
+	cacheCtx, write := ctx.CacheContext()
+	result := handler(cacheCtx, msg)
+	if result.IsOK() {
+		write()
+	}
+
+	return result
+}
+
+func newAccountWithCoins(ctx sdkTypes.Context, app *mxwApp, addr sdkTypes.AccAddress, amount int64) exported.Account {
+	acc := app.accountKeeper.NewAccountWithAddress(ctx, addr)
+	if err := acc.SetCoins(sdkTypes.NewCoins(sdkTypes.NewInt64Coin(types.CIN, amount))); err != nil {
+		panic(err)
+	}
+
+	return acc
+}
+
+func TestKycBind(t *testing.T) {
+	app, ctx, handler, keys := setupKyc(t)
+	querier := kyc.NewQuerier(&app.kycKeeper, &app.feeKeeper)
+	user, _, userAddr := KeyTestPubAddr()
+	other, _, otherAddr := KeyTestPubAddr()
+	wallet, _, walletAddr := KeyTestPubAddr()
+	for _, addr := range []sdkTypes.AccAddress{userAddr, otherAddr, walletAddr} {
+		app.accountKeeper.SetAccount(ctx, app.accountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+
+	assert.True(t, handler(ctx, makeMsgWhitelist(t, keys, user, "kyc-user", kyc.WhitelistExpiry{})).IsOK())
+	assert.True(t, handler(ctx, makeMsgWhitelist(t, keys, other, "kyc-other", kyc.WhitelistExpiry{})).IsOK())
+
+	// only the account whitelisted with the kyc address can bind to it, a whitelisted account can't be bound
+	assert.False(t, handler(ctx, makeMsgKycBind(t, app, ctx, otherAddr, wallet, "kyc-user")).IsOK())
+	assert.False(t, handler(ctx, makeMsgKycBind(t, app, ctx, walletAddr, other, "kyc-user")).IsOK())
+	assert.False(t, handler(ctx, makeMsgKycBind(t, app, ctx, userAddr, other, "kyc-user")).IsOK())
+	assert.True(t, handler(ctx, makeMsgKycBind(t, app, ctx, userAddr, wallet, "kyc-user")).IsOK())
+	assert.False(t, handler(ctx, makeMsgKycBind(t, app, ctx, otherAddr, wallet, "kyc-other")).IsOK())
+
+	walletTx := sdkAuth.StdTx{Msgs: []sdkTypes.Msg{bank.NewMsgSend(walletAddr, userAddr, sdkTypes.NewCoins(sdkTypes.NewInt64Coin(types.CIN, 1)))}}
+	assert.True(t, app.kycKeeper.CheckTx(ctx, walletTx))
+
+	bz, err := querier(ctx, []string{kyc.QueryGetKycBinding, walletAddr.String()}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	assert.Equal(t, "kyc-user", string(bz))
+
+	bz, err = querier(ctx, []string{kyc.QueryGetKycBindings, "kyc-user"}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	var wallets []sdkTypes.AccAddress
+	app.cdc.MustUnmarshalJSON(bz, &wallets)
+	assert.Equal(t, []sdkTypes.AccAddress{walletAddr}, wallets)
+
+	// a kyc address has a limited number of wallets
+	for i := 1; i < kyc.MaxKycBindings; i++ {
+		key, _, _ := KeyTestPubAddr()
+		assert.True(t, handler(ctx, makeMsgKycBind(t, app, ctx, userAddr, key, "kyc-user")).IsOK())
+	}
+	extra, _, _ := KeyTestPubAddr()
+	assert.False(t, handler(ctx, makeMsgKycBind(t, app, ctx, userAddr, extra, "kyc-user")).IsOK())
+	assert.Len(t, kyc.ExportGenesis(ctx, &app.kycKeeper).KycBindings, kyc.MaxKycBindings)
+
+	// the wallet itself or the whitelisted account can unbind it
+	assert.False(t, handler(ctx, kyc.NewMsgKycUnbind(otherAddr, walletAddr, "kyc-user")).IsOK())
+	assert.True(t, handler(ctx, kyc.NewMsgKycUnbind(walletAddr, walletAddr, "kyc-user")).IsOK())
+	assert.False(t, app.kycKeeper.CheckTx(ctx, walletTx))
+
+	// revoking the whitelisted account unbinds its wallets
+	app.kycKeeper.RevokeWhitelist(ctx, userAddr, keys.authorised)
+	assert.Empty(t, app.kycKeeper.GetKycBindings(ctx, "kyc-user"))
+	assert.Empty(t, app.kycKeeper.ListAllKycBindings(ctx))
+}
+
+func TestKycBindRequiresWalletSignature(t *testing.T) {
+	app, ctx, handler, keys := setupKyc(t)
+	user, _, userAddr := KeyTestPubAddr()
+	wallet, _, walletAddr := KeyTestPubAddr()
+
+	assert.True(t, handler(ctx, makeMsgWhitelist(t, keys, user, "kyc-user", kyc.WhitelistExpiry{})).IsOK())
+
+	// the wallet has to sign the bind, not the whitelisted account
+	unsigned := kyc.NewMsgKycBind(userAddr, walletAddr, "kyc-user", "0")
+	assert.Equal(t, sdkTypes.CodeUnauthorized, unsigned.ValidateBasic().Code())
+	signedByUser := unsigned
+	sig, err := user.Sign(unsigned.GetToSignBytes())
+	assert.NoError(t, err)
+	signedByUser.Signature = kyc.NewSignature(user.PubKey(), sig)
+	assert.Equal(t, sdkTypes.CodeUnauthorized, signedByUser.ValidateBasic().Code())
+	assert.Equal(t, sdkTypes.CodeUnauthorized, handler(ctx, kyc.NewMsgKycBind(userAddr, walletAddr, "kyc-user", "0")).Code)
+	assert.Equal(t, sdkTypes.CodeUnauthorized, handler(ctx, signedByUser).Code)
+	assert.False(t, app.kycKeeper.IsKycBound(ctx, walletAddr))
+
+	bind := makeMsgKycBind(t, app, ctx, userAddr, wallet, "kyc-user")
+	assert.Nil(t, bind.ValidateBasic())
+	assert.True(t, handler(ctx, bind).IsOK())
+	assert.Equal(t, uint64(1), app.accountKeeper.GetAccount(ctx, walletAddr).GetSequence())
+
+	// the signature can't be used again once the wallet is unbound
+	assert.True(t, handler(ctx, kyc.NewMsgKycUnbind(userAddr, walletAddr, "kyc-user")).IsOK())
+	assert.Equal(t, sdkTypes.CodeInvalidSequence, handler(ctx, bind).Code)
+	assert.True(t, handler(ctx, makeMsgKycBind(t, app, ctx, userAddr, wallet, "kyc-user")).IsOK())
+}
+
+func TestKycRegistryQueries(t *testing.T) {
+	app, ctx, handler, keys := setupKyc(t)
+	querier := kyc.NewQuerier(&app.kycKeeper, &app.feeKeeper)
+
+	var users []sdkTypes.AccAddress
+	for _, kycAddress := range []string{"kyc-1", "kyc-2", "kyc-3"} {
+		user, _, userAddr := KeyTestPubAddr()
+		assert.True(t, handler(ctx, makeMsgWhitelist(t, keys, user, kycAddress, kyc.WhitelistExpiry{})).IsOK())
+		users = append(users, userAddr)
+	}
+
+	// the authorised, issuer and provider addresses of the genesis are whitelisted too
+	listPage := func(path ...string) kyc.WhitelistedAccountsPage {
+		bz, err := querier(ctx, append([]string{kyc.QueryListWhitelisted}, path...), abci.RequestQuery{})
+		assert.Nil(t, err)
+		var page kyc.WhitelistedAccountsPage
+		app.cdc.MustUnmarshalJSON(bz, &page)
+		return page
+	}
+
+	first := listPage("1", "4")
+	second := listPage("2", "4")
+	assert.Equal(t, 6, first.Total)
+	assert.Len(t, first.Accounts, 4)
+	assert.Len(t, second.Accounts, 2)
+	assert.Len(t, listPage().Accounts, 6)
+	assert.Empty(t, listPage("3", "4").Accounts)
+	// (page-1)*limit of a huge page would wrap around to the first page
+	assert.Empty(t, listPage("4611686018427387905", "4").Accounts)
+	assert.Empty(t, listPage(strconv.Itoa(math.MaxInt64), "1000").Accounts)
+
+	heights := make(map[string]int64)
+	for _, account := range append(first.Accounts, second.Accounts...) {
+		heights[account.KycAddress] = account.WhitelistedHeight
+	}
+	assert.Equal(t, int64(2), heights["kyc-1"])
+	assert.Equal(t, int64(0), heights[keys.authorised.String()])
+
+	_, err := querier(ctx, []string{kyc.QueryListWhitelisted, "0"}, abci.RequestQuery{})
+	assert.NotNil(t, err)
+	_, err = querier(ctx, []string{kyc.QueryListWhitelisted, "1", "1001"}, abci.RequestQuery{})
+	assert.NotNil(t, err)
+
+	bz, err := querier(ctx, []string{kyc.QueryGetWhitelistedAddress, "kyc-2"}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	assert.Equal(t, users[1].String(), string(bz))
+	_, err = querier(ctx, []string{kyc.QueryGetWhitelistedAddress, "kyc-unknown"}, abci.RequestQuery{})
+	assert.NotNil(t, err)
+
+	bz, err = querier(ctx, []string{kyc.QueryListIssuers}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	var issuers []sdkTypes.AccAddress
+	app.cdc.MustUnmarshalJSON(bz, &issuers)
+	assert.Equal(t, []sdkTypes.AccAddress{sdkTypes.AccAddress(keys.issuer.PubKey().Address())}, issuers)
+}
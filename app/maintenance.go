@@ -1,7 +1,11 @@
 package app
 
 import (
+	"fmt"
+
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maxonrow/maxonrow-go/x/fee"
+	"github.com/maxonrow/maxonrow-go/x/kyc"
 	"github.com/maxonrow/maxonrow-go/x/maintenance"
 )
 
@@ -66,6 +70,24 @@ func (app *mxwApp) executeProposal(ctx sdkTypes.Context, proposal maintenance.Pr
 		if executeErr != nil {
 			return executeErr
 		}
+	case maintenance.ProposalTypeModifyFeeDistribution:
+		feeDistributionMaintainer, ok := proposal.ProposalData.(maintenance.FeeDistributionMaintainer)
+		if !ok {
+			return sdkTypes.ErrInternal("Converting to fee distribution maintainer failed.")
+		}
+		executeErr := app.executeFeeDistributionProposal(ctx, feeDistributionMaintainer)
+		if executeErr != nil {
+			return executeErr
+		}
+	case maintenance.ProposalTypeModifyKycTierLimit:
+		kycTierLimitMaintainer, ok := proposal.ProposalData.(maintenance.KycTierLimitMaintainer)
+		if !ok {
+			return sdkTypes.ErrInternal("Converting to kyc tier limit maintainer failed.")
+		}
+		executeErr := app.executeKycTierLimitProposal(ctx, kycTierLimitMaintainer)
+		if executeErr != nil {
+			return executeErr
+		}
 	case maintenance.ProposalTypesModifyValidatorSet:
 		whitelistValidator, ok := proposal.ProposalData.(maintenance.WhitelistValidator)
 		if !ok {
@@ -379,6 +401,49 @@ func (app *mxwApp) executeNonFungibleProposal(ctx sdkTypes.Context, nonFungibleM
 	return nil
 }
 
+// Handle fee distribution proposal
+func (app *mxwApp) executeFeeDistributionProposal(ctx sdkTypes.Context, feeDistributionMaintainer maintenance.FeeDistributionMaintainer) sdkTypes.Error {
+	if !feeDistributionMaintainer.TreasuryAddress.Empty() && !app.kycKeeper.IsWhitelisted(ctx, feeDistributionMaintainer.TreasuryAddress) {
+		return sdkTypes.ErrInternal("Address has to be whitelisted.")
+	}
+
+	var collectors []fee.ModuleFeeShare
+	for _, collector := range feeDistributionMaintainer.Collectors {
+		collectors = append(collectors, fee.ModuleFeeShare{
+			Module:     collector.Module,
+			Percentage: collector.Percentage,
+		})
+	}
+
+	return app.feeKeeper.SetFeeDistribution(ctx, fee.FeeDistribution{
+		Validators:      feeDistributionMaintainer.Validators,
+		Treasury:        feeDistributionMaintainer.Treasury,
+		TreasuryAddress: feeDistributionMaintainer.TreasuryAddress,
+		Burn:            feeDistributionMaintainer.Burn,
+		Collectors:      collectors,
+	})
+}
+
+// Handle kyc tier limit proposal
+func (app *mxwApp) executeKycTierLimitProposal(ctx sdkTypes.Context, kycTierLimitMaintainer maintenance.KycTierLimitMaintainer) sdkTypes.Error {
+	var limits []kyc.TransferLimit
+	for _, limit := range kycTierLimitMaintainer.Limits {
+		amount, err := sdkTypes.ParseUint(limit.Amount)
+		if err != nil {
+			return sdkTypes.ErrInternal(fmt.Sprintf("Invalid limit amount of %s: %s", limit.Denom, limit.Amount))
+		}
+		limits = append(limits, kyc.TransferLimit{
+			Denom:  limit.Denom,
+			Amount: amount,
+		})
+	}
+
+	return app.kycKeeper.SetTierLimit(ctx, kyc.TierLimit{
+		Tier:   kycTierLimitMaintainer.Tier,
+		Limits: limits,
+	})
+}
+
 func (app *mxwApp) executeWhitelistValidator(ctx sdkTypes.Context, whitelistValidator maintenance.WhitelistValidator) sdkTypes.Error {
 	switch whitelistValidator.Action {
 	case maintenance.ADD:
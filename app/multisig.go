@@ -0,0 +1,68 @@
+package app
+
+import (
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/maxonrow/maxonrow-go/x/auth"
+)
+
+// runMultiSigTx runs the pending tx of a multisig account inside the DeliverTx of the tx that signs it last.
+// The pending tx goes through the multisig ante handler, which checks it like any other tx but its signature,
+// and its msgs through the router. Its state changes are written only when all of them succeed.
+func (app *mxwApp) runMultiSigTx(ctx sdkTypes.Context, groupAddress sdkTypes.AccAddress, tx sdkTypes.Tx) sdkTypes.Result {
+	stdTx, ok := tx.(sdkAuth.StdTx)
+	if !ok {
+		return sdkTypes.ErrInternal("Tx must be StdTx.").Result()
+	}
+
+	// the signatures of the group are for its own account only
+	if !auth.IsGroupTx(stdTx, groupAddress) {
+		return sdkTypes.ErrUnauthorized("Pending tx must be signed by the group address only.").Result()
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	if !app.kycKeeper.CheckTx(cacheCtx, stdTx) {
+		return sdkTypes.NewError("mxw", 1000, "All signers must pass kyc.").Result()
+	}
+
+	newCtx, err := app.multiSigAnteHandler(cacheCtx, tx, false)
+	if err != nil {
+		sdkErr, ok := err.(sdkTypes.Error)
+		if !ok {
+			sdkErr = sdkTypes.ErrInternal(err.Error())
+		}
+		return sdkErr.Result()
+	}
+
+	var logs []string
+	events := sdkTypes.EmptyEvents()
+	for _, msg := range tx.GetMsgs() {
+		err := msg.ValidateBasic()
+		if err != nil {
+			return err.Result()
+		}
+
+		handler := app.router.Route(msg.Route())
+		if handler == nil {
+			return sdkTypes.ErrUnknownRequest("Unrecognized msg route: " + msg.Route()).Result()
+		}
+
+		result := handler(newCtx, msg)
+		if !result.IsOK() {
+			return result
+		}
+
+		events = events.AppendEvents(result.Events)
+		logs = append(logs, result.Log)
+	}
+
+	write()
+
+	return sdkTypes.Result{
+		Events: events,
+		Log:    strings.Join(logs, "\n"),
+	}
+}
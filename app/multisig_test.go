@@ -0,0 +1,293 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/maxonrow/maxonrow-go/genesis"
+	"github.com/maxonrow/maxonrow-go/types"
+	"github.com/maxonrow/maxonrow-go/x/auth"
+	"github.com/maxonrow/maxonrow-go/x/bank"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// setupMultiSig returns an app with a 2 of 2 multisig account of the owner and the signer.
+func setupMultiSig(t *testing.T) (*mxwApp, sdkTypes.Context, sdkTypes.Handler, sdkTypes.AccAddress, sdkTypes.AccAddress, sdkTypes.AccAddress) {
+	_, _, owner := KeyTestPubAddr()
+	_, _, signer := KeyTestPubAddr()
+
+	gen := genesis.NewDefaultGenesisState()
+	for _, addr := range []sdkTypes.AccAddress{owner, signer} {
+		acc := sdkAuth.NewBaseAccountWithAddress(addr)
+		gen.Accounts = append(gen.Accounts, &acc)
+	}
+	gen.FeeState.AuthorisedAddresses = []sdkTypes.AccAddress{owner}
+
+	appState, err := MakeDefaultCodec().MarshalJSON(gen)
+	assert.NoError(t, err)
+
+	app := NewMXWApp(log.NewNopLogger(), dbm.NewMemDB())
+	app.InitChain(abci.RequestInitChain{ChainId: "maxonrow-chain", AppStateBytes: appState})
+	app.Commit()
+	ctx := app.NewContext(true, abci.Header{ChainID: "maxonrow-chain", Height: 2})
+	handler := auth.NewHandler(app.accountKeeper, app.kycKeeper, &app.mxwAuthKeeper, app.txEncoder, app.runMultiSigTx)
+
+	app.kycKeeper.Whitelist(ctx, owner, "kyc:owner")
+	app.kycKeeper.Whitelist(ctx, signer, "kyc:signer")
+
+	groupAddress := auth.DeriveMultiSigAddress(owner, app.accountKeeper.GetAccount(ctx, owner).GetSequence())
+	result := handler(ctx, auth.NewMsgCreateMultiSigAccount(owner, 2, []sdkTypes.AccAddress{owner, signer}))
+	assert.True(t, result.IsOK())
+
+	_, err = app.bankKeeper.AddCoins(ctx, groupAddress, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(100000000000000000))))
+	assert.NoError(t, err)
+
+	return app, ctx, handler, owner, signer, groupAddress
+}
+
+func TestMultiSigTxExecution(t *testing.T) {
+	app, ctx, handler, owner, signer, groupAddress := setupMultiSig(t)
+	_, _, receiver := KeyTestPubAddr()
+
+	minFee := sdkAuth.NewStdFee(0, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(10000000000000000))))
+	tooMuch := bank.NewMsgSend(groupAddress, receiver, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(200000000000000000))))
+	send := bank.NewMsgSend(groupAddress, receiver, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000))))
+
+	result := handler(ctx, auth.NewMsgCreateMultiSigTx(groupAddress, sdkAuth.NewStdTx([]sdkTypes.Msg{tooMuch}, minFee, nil, ""), owner))
+	assert.True(t, result.IsOK())
+	result = handler(ctx, auth.NewMsgCreateMultiSigTx(groupAddress, sdkAuth.NewStdTx([]sdkTypes.Msg{send}, minFee, nil, ""), owner))
+	assert.True(t, result.IsOK())
+
+	// a failed pending tx stays pending with its result, and nothing of it is kept
+	result = handler(ctx, auth.NewMsgSignMultiSigTx(groupAddress, 1, signer))
+	assert.True(t, result.IsOK())
+
+	var resultLog types.ResultLog
+	assert.NoError(t, json.Unmarshal([]byte(result.Log), &resultLog))
+	assert.NotEmpty(t, resultLog.InternalHash)
+	assert.NotZero(t, resultLog.InternalCode)
+
+	txResult, ok := app.mxwAuthKeeper.GetMultiSigTxResult(ctx, groupAddress, 1)
+	assert.True(t, ok)
+	assert.False(t, txResult.IsOK())
+
+	groupAcc := app.accountKeeper.GetAccount(ctx, groupAddress)
+	_, contains := groupAcc.GetMultiSig().ContainTx(1)
+	assert.True(t, contains)
+	assert.Equal(t, "100000000000000000cin", groupAcc.GetCoins().String())
+
+	// the pending tx runs in the block it reaches the threshold
+	result = handler(ctx, auth.NewMsgSignMultiSigTx(groupAddress, 2, signer))
+	assert.True(t, result.IsOK())
+
+	resultLog = types.ResultLog{}
+	assert.NoError(t, json.Unmarshal([]byte(result.Log), &resultLog))
+	assert.Zero(t, resultLog.InternalCode)
+
+	txResult, ok = app.mxwAuthKeeper.GetMultiSigTxResult(ctx, groupAddress, 2)
+	assert.True(t, ok)
+	assert.True(t, txResult.IsOK())
+	assert.NotEmpty(t, txResult.Events)
+
+	groupAcc = app.accountKeeper.GetAccount(ctx, groupAddress)
+	_, contains = groupAcc.GetMultiSig().ContainTx(2)
+	assert.False(t, contains)
+	assert.Equal(t, "89999999999999000cin", groupAcc.GetCoins().String())
+	assert.Equal(t, "1000cin", app.accountKeeper.GetAccount(ctx, receiver).GetCoins().String())
+}
+
+func TestMultiSigPendingTxExpiry(t *testing.T) {
+	app, ctx, handler, owner, signer, groupAddress := setupMultiSig(t)
+	_, _, receiver := KeyTestPubAddr()
+	querier := auth.NewQuerier(app.cdc, app.accountKeeper, &app.mxwAuthKeeper)
+
+	minFee := sdkAuth.NewStdFee(0, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(10000000000000000))))
+	send := bank.NewMsgSend(groupAddress, receiver, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000))))
+
+	expired := auth.NewMsgCreateMultiSigTx(groupAddress, sdkAuth.NewStdTx([]sdkTypes.Msg{send}, minFee, nil, ""), owner)
+	expired.ExpiryHeight = 2
+	assert.False(t, handler(ctx, expired).IsOK())
+
+	expiring := auth.NewMsgCreateMultiSigTx(groupAddress, sdkAuth.NewStdTx([]sdkTypes.Msg{send}, minFee, nil, ""), owner)
+	expiring.ExpiryHeight = 5
+	assert.True(t, handler(ctx, expiring).IsOK())
+	assert.True(t, handler(ctx, auth.NewMsgCreateMultiSigTx(groupAddress, sdkAuth.NewStdTx([]sdkTypes.Msg{send}, minFee, nil, ""), owner)).IsOK())
+
+	bz, err := querier(ctx, []string{auth.QueryPendingTxs, groupAddress.String()}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	var pendingTxs []auth.PendingTxStatus
+	app.cdc.MustUnmarshalJSON(bz, &pendingTxs)
+	assert.Len(t, pendingTxs, 2)
+
+	bz, err = querier(ctx, []string{auth.QueryPendingTx, groupAddress.String(), "1"}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	var pendingTx auth.PendingTxStatus
+	app.cdc.MustUnmarshalJSON(bz, &pendingTx)
+	assert.Equal(t, []sdkTypes.AccAddress{owner}, pendingTx.SignedBy)
+	assert.Equal(t, []sdkTypes.AccAddress{signer}, pendingTx.PendingSigners)
+	assert.Equal(t, 2, pendingTx.Threshold)
+	assert.Equal(t, int64(5), pendingTx.ExpiryHeight)
+
+	// nothing expires before the expiry height
+	app.mxwAuthKeeper.PruneExpiredMultiSigTxs(ctx.WithBlockHeight(4), app.accountKeeper)
+	pendingTxs, _ = app.mxwAuthKeeper.ListPendingTxs(ctx, app.accountKeeper, groupAddress)
+	assert.Len(t, pendingTxs, 2)
+
+	events := app.mxwAuthKeeper.PruneExpiredMultiSigTxs(ctx.WithBlockHeight(5), app.accountKeeper)
+	assert.Len(t, events, 1)
+	pendingTxs, _ = app.mxwAuthKeeper.ListPendingTxs(ctx, app.accountKeeper, groupAddress)
+	assert.Len(t, pendingTxs, 1)
+	assert.Equal(t, uint64(2), pendingTxs[0].ID)
+	assert.Empty(t, app.mxwAuthKeeper.ListAllMultiSigTxExpiries(ctx))
+
+	_, err = querier(ctx, []string{auth.QueryPendingTx, groupAddress.String(), "1"}, abci.RequestQuery{})
+	assert.NotNil(t, err)
+
+	assert.True(t, handler(ctx, auth.NewMsgDeleteMultiSigTx(groupAddress, 2, owner)).IsOK())
+	pendingTxs, _ = app.mxwAuthKeeper.ListPendingTxs(ctx, app.accountKeeper, groupAddress)
+	assert.Empty(t, pendingTxs)
+}
+
+func TestMultiSigWeightedSigners(t *testing.T) {
+	app, ctx, handler, owner, signer, _ := setupMultiSig(t)
+	_, _, director := KeyTestPubAddr()
+	_, _, receiver := KeyTestPubAddr()
+	app.kycKeeper.Whitelist(ctx, director, "kyc:director")
+
+	// the owner weighs 2 and each director 1, the owner and a director together reach the threshold
+	groupAddress := auth.DeriveMultiSigAddress(owner, app.accountKeeper.GetAccount(ctx, owner).GetSequence())
+	create := auth.NewMsgCreateMultiSigAccount(owner, 3, []sdkTypes.AccAddress{owner, signer, director})
+	create.Weights = []uint64{2, 1, 1}
+	assert.True(t, handler(ctx, create).IsOK())
+	assert.Equal(t, uint64(2), app.mxwAuthKeeper.GetSignerWeight(ctx, groupAddress, owner))
+
+	_, err := app.bankKeeper.AddCoins(ctx, groupAddress, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(100000000000000000))))
+	assert.NoError(t, err)
+
+	minFee := sdkAuth.NewStdFee(0, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(10000000000000000))))
+	send := bank.NewMsgSend(groupAddress, receiver, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000))))
+
+	// two directors weigh 2, below the threshold
+	assert.True(t, handler(ctx, auth.NewMsgCreateMultiSigTx(groupAddress, sdkAuth.NewStdTx([]sdkTypes.Msg{send}, minFee, nil, ""), signer)).IsOK())
+	assert.True(t, handler(ctx, auth.NewMsgSignMultiSigTx(groupAddress, 1, director)).IsOK())
+
+	pendingTx, err := app.mxwAuthKeeper.GetPendingTx(ctx, app.accountKeeper, groupAddress, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), pendingTx.SignedWeight)
+	_, ok := app.mxwAuthKeeper.GetMultiSigTxResult(ctx, groupAddress, 1)
+	assert.False(t, ok)
+
+	// the owner and a director weigh 3
+	assert.True(t, handler(ctx, auth.NewMsgCreateMultiSigTx(groupAddress, sdkAuth.NewStdTx([]sdkTypes.Msg{send}, minFee, nil, ""), owner)).IsOK())
+	assert.True(t, handler(ctx, auth.NewMsgSignMultiSigTx(groupAddress, 2, director)).IsOK())
+
+	txResult, ok := app.mxwAuthKeeper.GetMultiSigTxResult(ctx, groupAddress, 2)
+	assert.True(t, ok)
+	assert.True(t, txResult.IsOK())
+	assert.Equal(t, "1000cin", app.accountKeeper.GetAccount(ctx, receiver).GetCoins().String())
+
+	// updating the signers without weights resets them to 1
+	assert.True(t, handler(ctx, auth.NewMsgDeleteMultiSigTx(groupAddress, 1, signer)).IsOK())
+	update := auth.NewMsgUpdateMultiSigAccount(owner, groupAddress, 2, []sdkTypes.AccAddress{owner, signer, director})
+	assert.True(t, handler(ctx, update).IsOK())
+	assert.Equal(t, uint64(1), app.mxwAuthKeeper.GetSignerWeight(ctx, groupAddress, owner))
+	assert.Empty(t, app.mxwAuthKeeper.ListSignerWeights(ctx, groupAddress))
+}
+
+func TestMultiSigRevokeSignature(t *testing.T) {
+	app, ctx, handler, owner, signer, groupAddress := setupMultiSig(t)
+	_, _, receiver := KeyTestPubAddr()
+
+	minFee := sdkAuth.NewStdFee(0, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(10000000000000000))))
+	send := bank.NewMsgSend(groupAddress, receiver, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000))))
+	assert.True(t, handler(ctx, auth.NewMsgCreateMultiSigTx(groupAddress, sdkAuth.NewStdTx([]sdkTypes.Msg{send}, minFee, nil, ""), owner)).IsOK())
+
+	// only a signer who signed the pending tx can revoke
+	assert.False(t, handler(ctx, auth.NewMsgRevokeMultiSigTxSignature(groupAddress, 1, signer)).IsOK())
+	assert.False(t, handler(ctx, auth.NewMsgRevokeMultiSigTxSignature(groupAddress, 2, owner)).IsOK())
+
+	result := handler(ctx, auth.NewMsgRevokeMultiSigTxSignature(groupAddress, 1, owner))
+	assert.True(t, result.IsOK())
+	assert.NotEmpty(t, result.Events)
+
+	pendingTx, err := app.mxwAuthKeeper.GetPendingTx(ctx, app.accountKeeper, groupAddress, 1)
+	assert.Nil(t, err)
+	assert.Empty(t, pendingTx.SignedBy)
+	assert.False(t, handler(ctx, auth.NewMsgRevokeMultiSigTxSignature(groupAddress, 1, owner)).IsOK())
+
+	// the revoked signature no longer counts to the threshold
+	assert.True(t, handler(ctx, auth.NewMsgSignMultiSigTx(groupAddress, 1, signer)).IsOK())
+	_, ok := app.mxwAuthKeeper.GetMultiSigTxResult(ctx, groupAddress, 1)
+	assert.False(t, ok)
+
+	assert.True(t, handler(ctx, auth.NewMsgSignMultiSigTx(groupAddress, 1, owner)).IsOK())
+	txResult, ok := app.mxwAuthKeeper.GetMultiSigTxResult(ctx, groupAddress, 1)
+	assert.True(t, ok)
+	assert.True(t, txResult.IsOK())
+
+	// an executed tx is no longer pending
+	assert.False(t, handler(ctx, auth.NewMsgRevokeMultiSigTxSignature(groupAddress, 1, owner)).IsOK())
+}
+
+func TestMultiSigRevokeSignatureStatus(t *testing.T) {
+	app, ctx, handler, owner, signer, groupAddress := setupMultiSig(t)
+	_, _, receiver := KeyTestPubAddr()
+
+	minFee := sdkAuth.NewStdFee(0, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(10000000000000000))))
+	tooMuch := bank.NewMsgSend(groupAddress, receiver, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(200000000000000000))))
+	assert.True(t, handler(ctx, auth.NewMsgCreateMultiSigTx(groupAddress, sdkAuth.NewStdTx([]sdkTypes.Msg{tooMuch}, minFee, nil, ""), owner)).IsOK())
+
+	// the tx reaches the threshold and fails, it stays pending with its result
+	assert.True(t, handler(ctx, auth.NewMsgSignMultiSigTx(groupAddress, 1, signer)).IsOK())
+	pendingTx, err := app.mxwAuthKeeper.GetPendingTx(ctx, app.accountKeeper, groupAddress, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), pendingTx.SignedWeight)
+	assert.NotNil(t, pendingTx.LastResult)
+
+	// the status of the tx no longer counts the revoked signer
+	assert.True(t, handler(ctx, auth.NewMsgRevokeMultiSigTxSignature(groupAddress, 1, signer)).IsOK())
+	pendingTx, err = app.mxwAuthKeeper.GetPendingTx(ctx, app.accountKeeper, groupAddress, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []sdkTypes.AccAddress{owner}, pendingTx.SignedBy)
+	assert.Equal(t, []sdkTypes.AccAddress{signer}, pendingTx.PendingSigners)
+	assert.Equal(t, uint64(1), pendingTx.SignedWeight)
+	assert.Nil(t, pendingTx.LastResult)
+	_, ok := app.mxwAuthKeeper.GetMultiSigTxResult(ctx, groupAddress, 1)
+	assert.False(t, ok)
+}
+
+func TestMultiSigCrossGroupTx(t *testing.T) {
+	app, ctx, handler, owner, signer, groupAddress := setupMultiSig(t)
+	_, _, receiver := KeyTestPubAddr()
+
+	otherGroupAddress := auth.DeriveMultiSigAddress(signer, app.accountKeeper.GetAccount(ctx, signer).GetSequence())
+	assert.True(t, handler(ctx, auth.NewMsgCreateMultiSigAccount(signer, 2, []sdkTypes.AccAddress{signer, owner})).IsOK())
+	_, err := app.bankKeeper.AddCoins(ctx, otherGroupAddress, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(100000000000000000))))
+	assert.NoError(t, err)
+
+	minFee := sdkAuth.NewStdFee(0, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(10000000000000000))))
+	send := bank.NewMsgSend(otherGroupAddress, receiver, sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000))))
+	tx := sdkAuth.NewStdTx([]sdkTypes.Msg{send}, minFee, nil, "")
+
+	// the signers of a group can't queue a tx of another group
+	result := handler(ctx, auth.NewMsgCreateMultiSigTx(groupAddress, tx, owner))
+	assert.Equal(t, sdkTypes.CodeUnauthorized, result.Code)
+	_, contains := app.accountKeeper.GetAccount(ctx, groupAddress).GetMultiSig().ContainTx(1)
+	assert.False(t, contains)
+
+	// nor run it with the signatures of their group
+	result = app.runMultiSigTx(ctx, groupAddress, tx)
+	assert.Equal(t, sdkTypes.CodeUnauthorized, result.Code)
+	assert.Equal(t, "100000000000000000cin", app.accountKeeper.GetAccount(ctx, otherGroupAddress).GetCoins().String())
+	assert.Nil(t, app.accountKeeper.GetAccount(ctx, receiver))
+
+	// a group account can't send an unsigned tx through the public ante handler
+	_, anteErr := app.NewAnteHandler()(ctx, tx, false)
+	assert.Error(t, anteErr)
+	assert.Equal(t, "100000000000000000cin", app.accountKeeper.GetAccount(ctx, otherGroupAddress).GetCoins().String())
+}
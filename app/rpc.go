@@ -55,6 +55,28 @@ type FeeInfo struct {
 	FeeSettings        []fee.FeeSetting
 }
 
+// FeeDetails is the fee of a tx with the quote of each msg.
+// Quotes are in cin, Fee is in the fee token when the tx pays its fee with one.
+type FeeDetails struct {
+	Fee      sdkAuth.StdFee `json:"fee"`
+	FeeToken *fee.FeeToken  `json:"fee_token,omitempty"`
+	Msgs     []fee.FeeQuote `json:"msgs"`
+}
+
+// AccountInfo is the account in amino JSON with the account flags kept outside the account.
+type AccountInfo struct {
+	Type         string          `json:"type"`
+	Value        json.RawMessage `json:"value"`
+	MemoRequired bool            `json:"memo_required"`
+}
+
+// TxInfo is the tx in amino JSON with the timeout height of the tx, which is kept in its memo.
+type TxInfo struct {
+	Type          string          `json:"type"`
+	Value         json.RawMessage `json:"value"`
+	TimeoutHeight int64           `json:"timeout_height,omitempty"`
+}
+
 type KYCInfo struct {
 	Providers        []sdkTypes.AccAddress
 	Issuers          []sdkTypes.AccAddress
@@ -71,9 +93,34 @@ func (app *mxwApp) DecodeTx(ctx *rpctypes.Context, bz []byte) (string, error) {
 	if err != nil {
 		return "", err1
 	}
-	return string(js), nil
+
+	stdTx, ok := tx.(sdkAuth.StdTx)
+	if !ok {
+		return string(js), nil
+	}
+
+	timeoutHeight, _, parseErr := types.ParseTimeoutMemo(stdTx.Memo)
+	if parseErr != nil || timeoutHeight == 0 {
+		return string(js), nil
+	}
+
+	var info TxInfo
+	jsonErr := json.Unmarshal(js, &info)
+	if jsonErr != nil {
+		return "", jsonErr
+	}
+	info.TimeoutHeight = timeoutHeight
+
+	out, jsonErr := json.Marshal(info)
+	if jsonErr != nil {
+		return "", jsonErr
+	}
+
+	return string(out), nil
 }
 
+// EncodeTx encodes the tx, the optional timeout_height next to the tx is written into its memo.
+// The timeout is part of the signed memo, so it has to be set before the tx is signed.
 func (app *mxwApp) EncodeTx(ctx *rpctypes.Context, js string) ([]byte, error) {
 	bz := parseJSON(js)
 	var tx sdkAuth.StdTx
@@ -81,6 +128,16 @@ func (app *mxwApp) EncodeTx(ctx *rpctypes.Context, js string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	var info TxInfo
+	err = json.Unmarshal(bz, &info)
+	if err != nil {
+		return nil, err
+	}
+	if info.TimeoutHeight > 0 {
+		tx.Memo = types.WithTimeoutHeight(tx.Memo, info.TimeoutHeight)
+	}
+
 	return app.txEncoder(tx)
 }
 
@@ -172,6 +229,22 @@ func (app *mxwApp) Account(ctx *rpctypes.Context, str string) (string, error) {
 		return "", err
 	}
 
+	if acc == nil {
+		return string(out), nil
+	}
+
+	var info AccountInfo
+	err = json.Unmarshal(out, &info)
+	if err != nil {
+		return "", err
+	}
+	info.MemoRequired = app.mxwAuthKeeper.IsMemoRequired(appCtx, addr)
+
+	out, err = json.Marshal(info)
+	if err != nil {
+		return "", err
+	}
+
 	return string(out), nil
 }
 
@@ -238,7 +311,7 @@ func (app *mxwApp) QueryFee(ctx *rpctypes.Context, js string) (sdkAuth.StdFee, e
 
 	// When the fee is empty, return zero
 	if fee.Empty() {
-		zero := sdkTypes.Coin{Amount: sdkTypes.NewInt(0), Denom: types.CIN}
+		zero := sdkTypes.Coin{Amount: sdkTypes.NewInt(0), Denom: getFeeDenom(tx)}
 		fee = sdkTypes.Coins{zero}
 	}
 
@@ -248,6 +321,50 @@ func (app *mxwApp) QueryFee(ctx *rpctypes.Context, js string) (sdkAuth.StdFee, e
 	return fees, nil
 }
 
+func (app *mxwApp) QueryFeeDetails(ctx *rpctypes.Context, js string) (FeeDetails, error) {
+
+	var details FeeDetails
+	appCtx := app.NewContext(true, abci.Header{})
+	bz := parseJSON(js)
+	var tx sdkAuth.StdTx
+	err := app.cdc.UnmarshalJSON(bz, &tx)
+	if err != nil {
+		return FeeDetails{}, err
+	}
+
+	quotes, feeErr := app.QuoteFee(appCtx, tx)
+	if feeErr != nil {
+		return FeeDetails{}, feeErr
+	}
+
+	var fee sdkTypes.Coins
+	for _, quote := range quotes {
+		fee = fee.Add(quote.Fee)
+	}
+
+	fee, feeErr = app.convertFee(appCtx, tx, fee)
+	if feeErr != nil {
+		return FeeDetails{}, feeErr
+	}
+
+	feeDenom := getFeeDenom(tx)
+	if feeDenom != types.CIN {
+		details.FeeToken, _ = app.feeKeeper.GetFeeToken(appCtx, feeDenom)
+	}
+
+	// When the fee is empty, return zero
+	if fee.Empty() {
+		zero := sdkTypes.Coin{Amount: sdkTypes.NewInt(0), Denom: feeDenom}
+		fee = sdkTypes.Coins{zero}
+	}
+
+	details.Fee.Amount = fee
+	details.Fee.Gas = 0
+	details.Msgs = quotes
+
+	return details, nil
+}
+
 func (app *mxwApp) GetLatestBlockHeight(ctx *rpctypes.Context) (int64, error) {
 
 	blockResult, err := rpc.Status(ctx)
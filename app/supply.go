@@ -0,0 +1,30 @@
+package app
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkSupply "github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// feeSupplyKeeper is the supply keeper the fees are distributed with, it tells if the total supply is stored before burning.
+type feeSupplyKeeper struct {
+	sdkSupply.Keeper
+	storeKey sdkTypes.StoreKey
+}
+
+func (k feeSupplyKeeper) HasSupply(ctx sdkTypes.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(sdkSupply.SupplyKey)
+}
+
+func (app *mxwApp) feeSupplyKeeper() feeSupplyKeeper {
+	return feeSupplyKeeper{app.supplyKeeper, app.keySupply}
+}
+
+// migrateSupply stores the total supply of the chains started before it was tracked, from the coins of the accounts.
+// It is called in BeginBlock, burning fees needs the total supply.
+func (app *mxwApp) migrateSupply(ctx sdkTypes.Context) {
+	if app.feeSupplyKeeper().HasSupply(ctx) {
+		return
+	}
+
+	sdkSupply.InitGenesis(ctx, app.supplyKeeper, app.accountKeeper, sdkSupply.DefaultGenesisState())
+}
@@ -104,7 +104,7 @@ func (app *mxwApp) validateMsg(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.
 					return types.ErrFeeSettingNotExists(val.FeeName)
 				}
 
-				if !fee.ContainAction(val.Action) {
+				if !fee.ContainAction(val.Action) || fee.IsNonFungibleAction(val.Action) {
 					return types.ErrInvalidTokenAction()
 				}
 			}
@@ -240,7 +240,7 @@ func (app *mxwApp) validateMsg(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.
 					return types.ErrFeeSettingNotExists(val.FeeName)
 				}
 
-				if !fee.ContainAction(val.Action) {
+				if !fee.IsNonFungibleAction(val.Action) {
 					return types.ErrInvalidTokenAction()
 				}
 			}
@@ -363,10 +363,28 @@ func (app *mxwApp) validateMsg(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.
 		if !app.feeKeeper.IsAuthorised(ctx, msg.Issuer) {
 			return sdkTypes.ErrUnauthorized("Not authorised to create fee setting.")
 		}
+	case fee.MsgSetFeeToken:
+		if !app.feeKeeper.IsAuthorised(ctx, msg.Issuer) {
+			return sdkTypes.ErrUnauthorized("Not authorised to set fee token.")
+		}
+		if !app.tokenKeeper.CheckApprovedToken(ctx, msg.Symbol) {
+			return types.ErrTokenInvalid()
+		}
+		if !app.kycKeeper.IsWhitelisted(ctx, msg.Collector) {
+			return sdkTypes.ErrUnauthorized("Fee token collector has to be whitelisted.")
+		}
+	case fee.MsgGrantFeeAllowance:
+		if !app.kycKeeper.IsWhitelisted(ctx, msg.Grantee) {
+			return sdkTypes.ErrUnauthorized("Fee allowance grantee has to be whitelisted.")
+		}
 	case bank.MsgMxwSend:
 		if !app.bankKeeper.HasCoins(ctx, msg.FromAddress, msg.Amount) {
 			return sdkTypes.ErrInsufficientCoins("Insufficient balance to do transaction.")
 		}
+	case bank.MsgMxwMultiSend:
+		if !app.bankKeeper.HasCoins(ctx, msg.FromAddress, msg.Total()) {
+			return sdkTypes.ErrInsufficientCoins("Insufficient balance to do transaction.")
+		}
 
 	default:
 		return nil
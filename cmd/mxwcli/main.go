@@ -31,10 +31,10 @@ import (
 	"github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/libs/cli"
 
-	//kycClient "github.com/maxonrow/maxonrow-go/x/kyc/client"
 	ver "github.com/maxonrow/maxonrow-go/version"
 	authClient "github.com/maxonrow/maxonrow-go/x/auth/client"
 	feeClient "github.com/maxonrow/maxonrow-go/x/fee/client"
+	kycClient "github.com/maxonrow/maxonrow-go/x/kyc/client"
 	maintenanceClient "github.com/maxonrow/maxonrow-go/x/maintenance/client"
 	nsClient "github.com/maxonrow/maxonrow-go/x/nameservice/client"
 	tokenClient "github.com/maxonrow/maxonrow-go/x/token/fungible/client"
@@ -69,7 +69,13 @@ func main() {
 
 	rootCmd.PersistentFlags().String(client.FlagChainID, "", "Chain ID of tendermint node")
 	rootCmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
-		return initConfig(rootCmd)
+		err := initConfig(rootCmd)
+		if err != nil {
+			return err
+		}
+
+		applyTimeoutHeight()
+		return nil
 	}
 
 	keyComd := keys.Commands()
@@ -101,7 +107,7 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 
 	maintenanceModuleClient := maintenanceClient.NewModuleClient(storeMaintenance, cdc)
 	nsModuleClient := nsClient.NewModuleClient(storeNS, cdc)
-	//kycModuleClient := kycClient.NewModuleClient(storeKyc, cdc)
+	kycModuleClient := kycClient.NewModuleClient(storeKyc, cdc)
 	tokenModuleClient := tokenClient.NewModuleClient(storeToken, cdc)
 	feeModuleClient := feeClient.NewModuleClient(storeFee, cdc)
 	authModuleClient := authClient.NewModuleClient(storeAuth, cdc)
@@ -119,6 +125,8 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 		rpc.BlockCommand(),
 		authcmd.QueryTxsByEventsCmd(cdc),
 		authcmd.QueryTxCmd(cdc),
+		QueryFeeDetailsCmd(cdc),
+		mxwAuthCmd.GetMemoRequired(cdc),
 		client.LineBreak,
 
 		// TO-DO: implement appmodulebasic interface in every module.
@@ -128,6 +136,7 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 		tokenModuleClient.GetQueryCmd(),
 		maintenanceModuleClient.GetQueryCmd(),
 		authModuleClient.GetQueryCmd(),
+		kycModuleClient.GetQueryCmd(),
 	)
 
 	// add modules' query commands
@@ -140,7 +149,7 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 
 	maintenanceModuleClient := maintenanceClient.NewModuleClient(storeMaintenance, cdc)
 	nsModuleClient := nsClient.NewModuleClient(storeNS, cdc)
-	//kycModuleClient := kycClient.NewModuleClient(storeKyc, cdc)
+	kycModuleClient := kycClient.NewModuleClient(storeKyc, cdc)
 	tokenModuleClient := tokenClient.NewModuleClient(storeToken, cdc)
 	feeModuleClient := feeClient.NewModuleClient(storeFee, cdc)
 	authModuleClient := authClient.NewModuleClient(storeAuth, cdc)
@@ -152,13 +161,16 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 
 	txCmd.AddCommand(
 		mxwAuthCmd.CreateMultiSigAccountCmd(cdc),
+		mxwAuthCmd.SetMemoRequiredCmd(cdc),
 		bankcmd.SendTxCmd(cdc),
+		bankcmd.MultiSendTxCmd(cdc),
 		client.LineBreak,
 		authcmd.GetSignCommand(cdc),
 		authcmd.GetMultiSignCommand(cdc),
 		client.LineBreak,
 		authcmd.GetBroadcastCommand(cdc),
 		authcmd.GetEncodeCommand(cdc),
+		DecodeTxCmd(cdc),
 		client.LineBreak,
 
 		// TO-DO: implement appmodulebasic interface in every module.
@@ -168,6 +180,7 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 		tokenModuleClient.GetTxCmd(),
 		maintenanceModuleClient.GetTxCmd(),
 		authModuleClient.GetTxCmd(),
+		kycModuleClient.GetTxCmd(),
 	)
 
 	// add modules' tx commands
@@ -184,6 +197,8 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 
 	txCmd.RemoveCommand(cmdsToRemove...)
 
+	txCmd.PersistentFlags().Int64(flagTimeoutHeight, 0, "Block height after which the tx is rejected, 0 for no timeout")
+
 	return txCmd
 }
 
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/maxonrow/maxonrow-go/app"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	rpcclient "github.com/tendermint/tendermint/rpc/lib/client"
+)
+
+// QueryFeeDetailsCmd explains the fee of an unsigned or signed tx, msg by msg.
+func QueryFeeDetailsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-details [file]",
+		Short: "Show how the fee of a tx is derived for every message",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			details := new(app.FeeDetails)
+			rpc := rpcclient.NewJSONRPCClient(viper.GetString(client.FlagNode))
+			rpc.SetCodec(cdc)
+			_, err = rpc.Call("query_fee_details", map[string]interface{}{"tx": string(bz)}, details)
+			if err != nil {
+				return err
+			}
+
+			out, err := cdc.MarshalJSONIndent(details, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(client.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+	viper.BindPFlag(client.FlagNode, cmd.Flags().Lookup(client.FlagNode))
+	return cmd
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/maxonrow/maxonrow-go/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const flagTimeoutHeight = "timeout-height"
+
+// applyTimeoutHeight writes the timeout height into the memo, before the tx commands build the tx.
+func applyTimeoutHeight() {
+	timeoutHeight := viper.GetInt64(flagTimeoutHeight)
+	if timeoutHeight > 0 {
+		viper.Set(flags.FlagMemo, types.WithTimeoutHeight(viper.GetString(flags.FlagMemo), timeoutHeight))
+	}
+}
+
+type decodedTx struct {
+	Tx            sdkAuth.StdTx `json:"tx"`
+	TimeoutHeight int64         `json:"timeout_height,omitempty"`
+}
+
+// DecodeTxCmd decodes a base64 encoded tx and shows its timeout height.
+func DecodeTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode [base64 tx]",
+		Short: "Decode a base64 encoded tx",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := base64.StdEncoding.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			var decoded decodedTx
+			err = cdc.UnmarshalBinaryLengthPrefixed(bz, &decoded.Tx)
+			if err != nil {
+				return err
+			}
+
+			decoded.TimeoutHeight, _, err = types.ParseTimeoutMemo(decoded.Tx.Memo)
+			if err != nil {
+				return err
+			}
+
+			out, err := cdc.MarshalJSONIndent(decoded, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(out))
+			return nil
+		},
+	}
+
+	return cmd
+}
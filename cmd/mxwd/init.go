@@ -76,6 +76,8 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 			feestate := []fee.AssignMsgFeeSetting{fee.AssignMsgFeeSetting{Name: "zero", MsgType: "kyc-whitelist"},
 				{Name: "zero", MsgType: "kyc-whitelist"},
 				{Name: "zero", MsgType: "kyc-revokeWhitelist"},
+				{Name: "zero", MsgType: "kyc-renewWhitelist"},
+				{Name: "zero", MsgType: "kyc-upgradeKycTier"},
 				{Name: "zero", MsgType: "fee-createFeeSetting"},
 				{Name: "zero", MsgType: "fee-createTxFeeSetting"},
 				{Name: "zero", MsgType: "fee-createMultiplier"},
@@ -134,6 +136,14 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("Unable to add account: %v", err)
 			}
 
+			//Add nonfungible collections
+			if fragmentPath := viper.GetString(flagNonFungibleGenesis); fragmentPath != "" {
+				genState, err = addNonFungibleCollections(cdc, genState, fragmentPath)
+				if err != nil {
+					return fmt.Errorf("Unable to add nonfungible collections: %v", err)
+				}
+			}
+
 			//Add account for fee
 			genState, accIndex, err = addAccountfee(ctx, cdc, genState, accIndex)
 			if err != nil {
@@ -170,6 +180,7 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagChainID, DefaultChainID, "genesis file chain-id")
 	cmd.Flags().String(client.FlagKeyringBackend, client.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
 	cmd.Flags().BoolP(flagOverwrite, "o", false, "overwrite the genesis.json file")
+	cmd.Flags().String(flagNonFungibleGenesis, "", "nonfungible genesis file with the tokens, items and mint limits to start with")
 
 	return cmd
 }
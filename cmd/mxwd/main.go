@@ -36,11 +36,13 @@ import (
 	"github.com/maxonrow/maxonrow-go/genesis"
 	"github.com/maxonrow/maxonrow-go/types"
 	"github.com/maxonrow/maxonrow-go/x/fee"
+	"github.com/maxonrow/maxonrow-go/x/token/nonfungible"
 )
 
 const (
-	flagOverwrite  = "overwrite"
-	DefaultChainID = "maxonrow-chain"
+	flagOverwrite          = "overwrite"
+	flagNonFungibleGenesis = "nonfungible-genesis"
+	DefaultChainID         = "maxonrow-chain"
 )
 
 var (
@@ -253,6 +255,8 @@ func InitAuto(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 			feestate := []fee.AssignMsgFeeSetting{fee.AssignMsgFeeSetting{Name: "zero", MsgType: "kyc-whitelist"},
 				{Name: "zero", MsgType: "kyc-whitelist"},
 				{Name: "zero", MsgType: "kyc-revokeWhitelist"},
+				{Name: "zero", MsgType: "kyc-renewWhitelist"},
+				{Name: "zero", MsgType: "kyc-upgradeKycTier"},
 				{Name: "zero", MsgType: "fee-createFeeSetting"},
 				{Name: "zero", MsgType: "fee-createTxFeeSetting"},
 				{Name: "zero", MsgType: "fee-createMultiplier"},
@@ -313,6 +317,14 @@ func InitAuto(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("Unable to add account: %v", err)
 			}
 
+			//Add nonfungible collections
+			if fragmentPath := viper.GetString(flagNonFungibleGenesis); fragmentPath != "" {
+				genState, err = addNonFungibleCollections(cdc, genState, fragmentPath)
+				if err != nil {
+					return fmt.Errorf("Unable to add nonfungible collections: %v", err)
+				}
+			}
+
 			//Add account for fee
 			genState, accIndex, err = addAccountfee(ctx, cdc, genState, accIndex)
 			if err != nil {
@@ -389,6 +401,7 @@ func InitAuto(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(cli.HomeFlag, DefaultNodeHome, "node's home directory")
 	cmd.Flags().String(client.FlagChainID, DefaultChainID, "genesis file chain-id")
 	cmd.Flags().BoolP(flagOverwrite, "o", false, "overwrite the genesis.json file")
+	cmd.Flags().String(flagNonFungibleGenesis, "", "nonfungible genesis file with the tokens, items and mint limits to start with")
 	return cmd
 }
 
@@ -489,19 +502,72 @@ func addAccounttoken(ctx *server.Context, cdc *codec.Codec, genState genesis.Gen
 	for ; j < 16; j++ {
 		addr := acc[j].Address
 		genState.TokenState.AuthorizedAddresses = append(genState.TokenState.AuthorizedAddresses, addr)
+		genState.NonFungibleTokenState.AuthorizedAddresses = append(genState.NonFungibleTokenState.AuthorizedAddresses, addr)
 	}
 	for ; j < 16+3; j++ {
 		addr := acc[j].Address
 		genState.TokenState.IssuerAddresses = append(genState.TokenState.IssuerAddresses, addr)
+		genState.NonFungibleTokenState.IssuerAddresses = append(genState.NonFungibleTokenState.IssuerAddresses, addr)
 	}
 	for ; j < 16+3+3; j++ {
 		addr := acc[j].Address
 		genState.TokenState.ProviderAddresses = append(genState.TokenState.ProviderAddresses, addr)
+		genState.NonFungibleTokenState.ProviderAddresses = append(genState.NonFungibleTokenState.ProviderAddresses, addr)
 		endIndex = j
 	}
+
+	// The tokens, items and mint limits stay empty unless they are loaded with addNonFungibleCollections.
+	genState.NonFungibleTokenState.Tokens = []nonfungible.Token{}
+	genState.NonFungibleTokenState.Items = []nonfungible.GenesisItem{}
+	genState.NonFungibleTokenState.MintLimits = []nonfungible.GenesisMintLimit{}
 	return genState, endIndex, nil
 }
 
+//Add nonfungible tokens, items and mint limits from a genesis fragment, it has the same format as the nonfungible state of an exported genesis
+func addNonFungibleCollections(cdc *codec.Codec, genState genesis.GenesisState, fragmentPath string) (genesis.GenesisState, error) {
+	bz, err := ioutil.ReadFile(fragmentPath)
+	if err != nil {
+		return genState, err
+	}
+
+	var fragment nonfungible.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &fragment); err != nil {
+		return genState, fmt.Errorf("Invalid nonfungible genesis %s: %v", fragmentPath, err)
+	}
+
+	symbols := make(map[string]bool)
+	for _, token := range genState.NonFungibleTokenState.Tokens {
+		symbols[token.Symbol] = true
+	}
+	for _, token := range fragment.Tokens {
+		if symbols[token.Symbol] {
+			return genState, fmt.Errorf("Duplicate nonfungible token %s", token.Symbol)
+		}
+		if token.Owner.Empty() {
+			return genState, fmt.Errorf("Invalid nonfungible token %s, owner is empty", token.Symbol)
+		}
+		symbols[token.Symbol] = true
+	}
+	for _, item := range fragment.Items {
+		if !symbols[item.Symbol] {
+			return genState, fmt.Errorf("Invalid item %s, token %s not found", item.Item.ID, item.Symbol)
+		}
+		if item.Owner.Empty() {
+			return genState, fmt.Errorf("Invalid item %s, owner is empty", item.Item.ID)
+		}
+	}
+	for _, mintLimit := range fragment.MintLimits {
+		if !symbols[mintLimit.Symbol] {
+			return genState, fmt.Errorf("Invalid mint limit, token %s not found", mintLimit.Symbol)
+		}
+	}
+
+	genState.NonFungibleTokenState.Tokens = append(genState.NonFungibleTokenState.Tokens, fragment.Tokens...)
+	genState.NonFungibleTokenState.Items = append(genState.NonFungibleTokenState.Items, fragment.Items...)
+	genState.NonFungibleTokenState.MintLimits = append(genState.NonFungibleTokenState.MintLimits, fragment.MintLimits...)
+	return genState, nil
+}
+
 //Add accounts to fee
 func addAccountfee(ctx *server.Context, cdc *codec.Codec, genState genesis.GenesisState, startIndex int) (genesis.GenesisState, int, error) {
 	var endIndex = 0
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maxonrow/maxonrow-go/app"
+	"github.com/maxonrow/maxonrow-go/genesis"
+	"github.com/maxonrow/maxonrow-go/x/token/nonfungible"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeNonFungibleGenesis(t *testing.T, dir string, state nonfungible.GenesisState) string {
+	bz, err := app.MakeDefaultCodec().MarshalJSON(state)
+	assert.NoError(t, err)
+
+	path := filepath.Join(dir, "nonfungible.json")
+	assert.NoError(t, ioutil.WriteFile(path, bz, 0644))
+	return path
+}
+
+func TestAddNonFungibleCollections(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mxwd")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cdc := app.MakeDefaultCodec()
+	owner := sdkTypes.AccAddress([]byte("owner_address_______"))
+	holder := sdkTypes.AccAddress([]byte("holder_address______"))
+
+	fragment := nonfungible.GenesisState{
+		Tokens: []nonfungible.Token{{
+			Flags:         nonfungible.NonFungibleTokenMask + nonfungible.ApprovedFlag,
+			Name:          "Test NFT",
+			Symbol:        "TNFT",
+			Owner:         owner,
+			TotalSupply:   sdkTypes.NewUint(2),
+			TransferLimit: sdkTypes.NewUint(0),
+			MintLimit:     sdkTypes.NewUint(5),
+		}},
+		Items: []nonfungible.GenesisItem{
+			{Symbol: "TNFT", Owner: owner, Item: nonfungible.Item{ID: "1", Properties: "p1", TransferLimit: sdkTypes.NewUint(0)}},
+			{Symbol: "TNFT", Owner: holder, Item: nonfungible.Item{ID: "2", Metadata: "m2", TransferLimit: sdkTypes.NewUint(0)}},
+		},
+		MintLimits: []nonfungible.GenesisMintLimit{{Symbol: "TNFT", Owner: owner, Counter: sdkTypes.NewUint(2)}},
+	}
+
+	genState, err := addNonFungibleCollections(cdc, genesis.NewDefaultGenesisState(), writeNonFungibleGenesis(t, dir, fragment))
+	assert.NoError(t, err)
+
+	// the collections reach the genesis written by mxwd init
+	bz, err := cdc.MarshalJSON(genState)
+	assert.NoError(t, err)
+	var written genesis.GenesisState
+	assert.NoError(t, cdc.UnmarshalJSON(bz, &written))
+	assert.Equal(t, cdc.MustMarshalJSON(fragment.Tokens), cdc.MustMarshalJSON(written.NonFungibleTokenState.Tokens))
+	assert.Equal(t, cdc.MustMarshalJSON(fragment.Items), cdc.MustMarshalJSON(written.NonFungibleTokenState.Items))
+	assert.Equal(t, cdc.MustMarshalJSON(fragment.MintLimits), cdc.MustMarshalJSON(written.NonFungibleTokenState.MintLimits))
+	assert.Len(t, written.NonFungibleTokenState.Items, 2)
+
+	// the same token can't be loaded twice
+	_, err = addNonFungibleCollections(cdc, genState, writeNonFungibleGenesis(t, dir, fragment))
+	assert.Error(t, err)
+
+	// an item needs its token
+	fragment.Tokens = nil
+	_, err = addNonFungibleCollections(cdc, genesis.NewDefaultGenesisState(), writeNonFungibleGenesis(t, dir, fragment))
+	assert.Error(t, err)
+
+	_, err = addNonFungibleCollections(cdc, genesis.NewDefaultGenesisState(), filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
@@ -10,42 +10,52 @@ import (
 	sdkDist "github.com/cosmos/cosmos-sdk/x/distribution"
 	sdkStaking "github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/maxonrow/maxonrow-go/types"
+	"github.com/maxonrow/maxonrow-go/x/auth"
 	"github.com/maxonrow/maxonrow-go/x/fee"
 	"github.com/maxonrow/maxonrow-go/x/kyc"
 	"github.com/maxonrow/maxonrow-go/x/maintenance"
 	"github.com/maxonrow/maxonrow-go/x/nameservice"
 	token "github.com/maxonrow/maxonrow-go/x/token/fungible"
+	nonFungible "github.com/maxonrow/maxonrow-go/x/token/nonfungible"
 )
 
 // Represents the state at the start. Should store initial accounts etc here
 type GenesisState struct {
-	AuthState        sdkAuth.GenesisState     `json:"auth"`
-	Accounts         []*sdkAuth.BaseAccount   `json:"accounts"`
-	BankState        sdkBank.GenesisState     `json:"bank"`
-	StakingState     sdkStaking.GenesisState  `json:"staking"`
-	DistrState       sdkDist.GenesisState     `json:"distribution"`
-	KycState         kyc.GenesisState         `json:"kyc"`
-	TokenState       token.GenesisState       `json:"token"`
-	NameServiceState nameservice.GenesisState `json:"nameservice"`
-	FeeState         fee.GenesisState         `json:"fee"`
-	MaintenanceState maintenance.GenesisState `json:"maintenance"`
-	GenTxs           []json.RawMessage        `json:"gentxs"`
+	AuthState             sdkAuth.GenesisState     `json:"auth"`
+	Accounts              []*sdkAuth.BaseAccount   `json:"accounts"`
+	BankState             sdkBank.GenesisState     `json:"bank"`
+	StakingState          sdkStaking.GenesisState  `json:"staking"`
+	DistrState            sdkDist.GenesisState     `json:"distribution"`
+	KycState              kyc.GenesisState         `json:"kyc"`
+	TokenState            token.GenesisState       `json:"token"`
+	NonFungibleTokenState nonFungible.GenesisState `json:"nonfungible"`
+	NameServiceState      nameservice.GenesisState `json:"nameservice"`
+	FeeState              fee.GenesisState         `json:"fee"`
+	MaintenanceState      maintenance.GenesisState `json:"maintenance"`
+	MxwAuthState          auth.GenesisState        `json:"mxw_auth"`
+	GenTxs                []json.RawMessage        `json:"gentxs"`
+
+	// KeepAccountNumbers is set by the export, the accounts are restored with their account numbers.
+	// The accounts of a new chain have no account number, they are numbered in order.
+	KeepAccountNumbers bool `json:"keep_account_numbers,omitempty"`
 }
 
 // NewDefaultGenesisState generates the default state.
 func NewDefaultGenesisState() GenesisState {
 	gen := GenesisState{
-		AuthState:        sdkAuth.DefaultGenesisState(),
-		Accounts:         nil,
-		BankState:        sdkBank.DefaultGenesisState(),
-		StakingState:     sdkStaking.DefaultGenesisState(),
-		DistrState:       sdkDist.DefaultGenesisState(),
-		KycState:         kyc.DefaultGenesisState(),
-		TokenState:       token.DefaultGenesisState(),
-		NameServiceState: nameservice.DefaultGenesisState(),
-		FeeState:         fee.DefaultGenesisState(),
-		MaintenanceState: maintenance.DefaultGenesisState(),
-		GenTxs:           nil,
+		AuthState:             sdkAuth.DefaultGenesisState(),
+		Accounts:              nil,
+		BankState:             sdkBank.DefaultGenesisState(),
+		StakingState:          sdkStaking.DefaultGenesisState(),
+		DistrState:            sdkDist.DefaultGenesisState(),
+		KycState:              kyc.DefaultGenesisState(),
+		TokenState:            token.DefaultGenesisState(),
+		NonFungibleTokenState: nonFungible.DefaultGenesisState(),
+		NameServiceState:      nameservice.DefaultGenesisState(),
+		FeeState:              fee.DefaultGenesisState(),
+		MaintenanceState:      maintenance.DefaultGenesisState(),
+		MxwAuthState:          auth.DefaultGenesisState(),
+		GenTxs:                nil,
 	}
 
 	gen.AuthState.Params.TxSizeCostPerByte = uint64(0)
@@ -120,5 +130,26 @@ func (s *GenesisState) Validate() error {
 		accounts[addrStr] = true
 	}
 
+	// exported accounts keep their account numbers, which must be unique
+	if s.KeepAccountNumbers {
+		accountNumbers := make(map[uint64]string)
+
+		for _, initialAccount := range s.Accounts {
+			if addrStr, exists := accountNumbers[initialAccount.AccountNumber]; exists {
+				return fmt.Errorf("Duplicate account number %d of %s and %s in genesis", initialAccount.AccountNumber, addrStr, initialAccount.Address.String())
+			}
+
+			accountNumbers[initialAccount.AccountNumber] = initialAccount.Address.String()
+		}
+
+		for _, moduleAccount := range s.AuthState.Accounts {
+			if addrStr, exists := accountNumbers[moduleAccount.GetAccountNumber()]; exists {
+				return fmt.Errorf("Duplicate account number %d of %s and %s in genesis", moduleAccount.GetAccountNumber(), addrStr, moduleAccount.GetAddress().String())
+			}
+
+			accountNumbers[moduleAccount.GetAccountNumber()] = moduleAccount.GetAddress().String()
+		}
+	}
+
 	return nil
 }
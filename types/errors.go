@@ -34,6 +34,13 @@ const (
 	CodeAliasNotFound               sdkTypes.CodeType = 4004
 	CodeAliasCouldNotResolveAddress sdkTypes.CodeType = 4005
 
+	// Account
+	CodeMemoRequired sdkTypes.CodeType = 5001
+	CodeTxTimeout    sdkTypes.CodeType = 5002
+
+	// Kyc
+	CodeKycTransferLimitExceeded sdkTypes.CodeType = 6001
+
 	CodespaceMXW sdkTypes.CodespaceType = "mxw"
 )
 
@@ -135,3 +142,17 @@ func ErrInvalidEndorser() sdkTypes.Error {
 func ErrTokenItemFronzen() sdkTypes.Error {
 	return newErrorWithMXWCodespace(CodeTokenItemFrozen, "Token item frozen.")
 }
+
+/// --- Account errors
+func ErrMemoRequired(addr string) sdkTypes.Error {
+	return newErrorWithMXWCodespace(CodeMemoRequired, "Memo is required to transfer to: %s", addr)
+}
+
+func ErrTxTimeout(timeoutHeight, blockHeight int64) sdkTypes.Error {
+	return newErrorWithMXWCodespace(CodeTxTimeout, "Tx timed out at height %d, current height: %d", timeoutHeight, blockHeight)
+}
+
+/// --- Kyc errors
+func ErrKycTransferLimitExceeded(addr, tier, limit string) sdkTypes.Error {
+	return newErrorWithMXWCodespace(CodeKycTransferLimitExceeded, "Daily transfer limit of kyc tier %s is exceeded by: %s, limit: %s", tier, addr, limit)
+}
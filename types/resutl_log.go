@@ -11,6 +11,8 @@ type ResultLog struct {
 	Hash         common.HexBytes `json:"hash"`
 	InternalHash common.HexBytes `json:"internalHash"`
 	Nonce        uint64          `json:"nonce"`
+	InternalCode uint32          `json:"internalCode,omitempty"`
+	InternalLog  string          `json:"internalLog,omitempty"`
 }
 
 func NewResultLog(nonce uint64, txBytes []byte) *ResultLog {
@@ -29,6 +31,13 @@ func (r *ResultLog) WithInternalHash(internalHash common.HexBytes) *ResultLog {
 	return r
 }
 
+// WithInternalResult keeps the result of the tx executed by this tx, like a multisig pending tx.
+func (r *ResultLog) WithInternalResult(code uint32, log string) *ResultLog {
+	r.InternalCode = code
+	r.InternalLog = log
+	return r
+}
+
 func (r *ResultLog) String() string {
 	respData, _ := json.Marshal(r)
 	return string(respData)
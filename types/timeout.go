@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TimeoutMemoPrefix reserves the start of the memo for the timeout height of the tx,
+// as in timeout:1200 or timeout:1200;memo. Keeping it in the memo leaves the amino StdTx as is.
+const (
+	TimeoutMemoPrefix    = "timeout:"
+	TimeoutMemoSeparator = ";"
+)
+
+// ParseTimeoutMemo splits the memo into the timeout height and the rest of the memo.
+// Zero height means the tx has no timeout.
+func ParseTimeoutMemo(memo string) (int64, string, error) {
+	if !strings.HasPrefix(memo, TimeoutMemoPrefix) {
+		return 0, memo, nil
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(memo, TimeoutMemoPrefix), TimeoutMemoSeparator, 2)
+	height, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || height <= 0 {
+		return 0, memo, fmt.Errorf("Invalid timeout height in memo: %s", parts[0])
+	}
+
+	rest := ""
+	if len(parts) == 2 {
+		rest = parts[1]
+	}
+
+	return height, rest, nil
+}
+
+// WithTimeoutHeight returns the memo carrying the timeout height, replacing the timeout already in it.
+// Zero height removes the timeout.
+func WithTimeoutHeight(memo string, height int64) string {
+	_, rest, err := ParseTimeoutMemo(memo)
+	if err != nil {
+		rest = memo
+	}
+
+	if height <= 0 {
+		return rest
+	}
+
+	timeout := TimeoutMemoPrefix + strconv.FormatInt(height, 10)
+	if rest == "" {
+		return timeout
+	}
+
+	return timeout + TimeoutMemoSeparator + rest
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeoutMemo(t *testing.T) {
+	height, rest, err := ParseTimeoutMemo("deposit 1234")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), height)
+	assert.Equal(t, "deposit 1234", rest)
+
+	height, rest, err = ParseTimeoutMemo("timeout:1200")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1200), height)
+	assert.Equal(t, "", rest)
+
+	height, rest, err = ParseTimeoutMemo("timeout:1200;deposit;1234")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1200), height)
+	assert.Equal(t, "deposit;1234", rest)
+
+	_, _, err = ParseTimeoutMemo("timeout:abc;deposit")
+	assert.Error(t, err)
+
+	_, _, err = ParseTimeoutMemo("timeout:-1")
+	assert.Error(t, err)
+}
+
+func TestWithTimeoutHeight(t *testing.T) {
+	assert.Equal(t, "timeout:1200", WithTimeoutHeight("", 1200))
+	assert.Equal(t, "timeout:1200;deposit", WithTimeoutHeight("deposit", 1200))
+	assert.Equal(t, "timeout:1500;deposit", WithTimeoutHeight("timeout:1200;deposit", 1500))
+	assert.Equal(t, "deposit", WithTimeoutHeight("timeout:1200;deposit", 0))
+}
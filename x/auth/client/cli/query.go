@@ -34,3 +34,105 @@ func GetMultiSigAcc(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func GetMemoRequired(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "memo-required [address]",
+		Short: "check if transfers to the account require a memo",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", "auth", auth.QueryIsMemoRequired, addr), nil)
+			if err != nil {
+				fmt.Printf("Could not check memo required: %s\n", err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func GetMultiSigTxResult(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-result [group address] [tx id]",
+		Short: "get the result of executing a multisig pending tx",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			groupAddr := args[0]
+			txID := args[1]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", "auth", auth.QueryMultiSigTxResult, groupAddr, txID), nil)
+			if err != nil {
+				fmt.Printf("Could not get multisig tx result: %s\n", err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func GetPendingTxs(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-txs [group address]",
+		Short: "list the pending txs of multisig account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			groupAddr := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", "auth", auth.QueryPendingTxs, groupAddr), nil)
+			if err != nil {
+				fmt.Printf("Could not list pending txs: %s\n", err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func GetPendingTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-tx [group address] [tx id]",
+		Short: "get a pending tx of multisig account with its signers",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			groupAddr := args[0]
+			txID := args[1]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", "auth", auth.QueryPendingTx, groupAddr, txID), nil)
+			if err != nil {
+				fmt.Printf("Could not get pending tx: %s\n", err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -18,6 +18,8 @@ import (
 const (
 	flagMultisig          = "multisig"
 	flagMultiSigThreshold = "multisig-threshold"
+	flagMultiSigWeights   = "multisig-weights"
+	flagExpiryHeight      = "expiry-height"
 )
 
 func CreateMultiSigAccountCmd(cdc *codec.Codec) *cobra.Command {
@@ -51,12 +53,17 @@ func CreateMultiSigAccountCmd(cdc *codec.Codec) *cobra.Command {
 			}
 
 			msg := auth.NewMsgCreateMultiSigAccount(master, multisigThreshold, signers)
+			msg.Weights, err = getWeights(cmd)
+			if err != nil {
+				return err
+			}
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdkTypes.Msg{msg})
 		},
 	}
 
 	cmd.Flags().StringSlice(flagMultisig, nil, "list of signers eg. "+strconv.Quote("acc1,acc2,acc3")+" by local wallet names.")
 	cmd.Flags().Uint(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
+	cmd.Flags().UintSlice(flagMultiSigWeights, nil, "weight of each signer in the order of --multisig, the weight of the signatures must reach the threshold. Every signer weighs 1 by default")
 
 	//cmd = client.PostCommands(cmd)[0]
 
@@ -99,12 +106,17 @@ func UpdateMultiSigAccountCmd(cdc *codec.Codec) *cobra.Command {
 			}
 
 			msg := auth.NewMsgUpdateMultiSigAccount(owner, groupAddress, multisigThreshold, signers)
+			msg.NewWeights, err = getWeights(cmd)
+			if err != nil {
+				return err
+			}
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdkTypes.Msg{msg})
 		},
 	}
 
 	cmd.Flags().StringSlice(flagMultisig, nil, "list of signers eg. "+strconv.Quote("acc1,acc2,acc3")+" by local wallet names.")
 	cmd.Flags().Uint(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
+	cmd.Flags().UintSlice(flagMultiSigWeights, nil, "weight of each signer in the order of --multisig, the weight of the signatures must reach the threshold. Every signer weighs 1 by default")
 
 	//cmd = client.PostCommands(cmd)[0]
 
@@ -140,3 +152,156 @@ func TransferMultiSigAccountOwnershipCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func SetMemoRequiredCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-memo-required [true|false]",
+		Short: "Require a memo on transfers to the account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := sdkAuth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			required, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := auth.NewMsgSetMemoRequired(cliCtx.GetFromAddress(), required)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdkTypes.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func CreateMultiSigTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-tx [group address] [tx file]",
+		Short: "Create a pending tx of multi signature account from an unsigned tx",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := sdkAuth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			groupAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := auth.NewMsgCreateMultiSigTx(groupAddress, stdTx, cliCtx.GetFromAddress())
+			msg.ExpiryHeight = viper.GetInt64(flagExpiryHeight)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdkTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64(flagExpiryHeight, 0, "Block height the pending tx is removed at, 0 for no expiry")
+
+	return cmd
+}
+
+func SignMultiSigTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-tx [group address] [tx id]",
+		Short: "Sign a pending tx of multi signature account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := sdkAuth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			groupAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			txID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := auth.NewMsgSignMultiSigTx(groupAddress, txID, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdkTypes.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func DeleteMultiSigTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-tx [group address] [tx id]",
+		Short: "Delete a pending tx of multi signature account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := sdkAuth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			groupAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			txID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := auth.NewMsgDeleteMultiSigTx(groupAddress, txID, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdkTypes.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func RevokeMultiSigTxSignatureCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-signature [group address] [tx id]",
+		Short: "Revoke your signature of a pending tx of multi signature account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := sdkAuth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			groupAddress, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			txID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := auth.NewMsgRevokeMultiSigTxSignature(groupAddress, txID, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdkTypes.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// getWeights returns the weights of the signers given by the weights flag.
+func getWeights(cmd *cobra.Command) ([]uint64, error) {
+	flagWeights, err := cmd.Flags().GetUintSlice(flagMultiSigWeights)
+	if err != nil {
+		return nil, err
+	}
+
+	var weights []uint64
+	for _, weight := range flagWeights {
+		weights = append(weights, uint64(weight))
+	}
+
+	return weights, nil
+}
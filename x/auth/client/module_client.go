@@ -24,6 +24,9 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(client.GetCommands(
 		multiSigCmd.GetMultiSigAcc(mc.cdc),
+		multiSigCmd.GetMultiSigTxResult(mc.cdc),
+		multiSigCmd.GetPendingTxs(mc.cdc),
+		multiSigCmd.GetPendingTx(mc.cdc),
 	)...)
 
 	return queryCmd
@@ -39,6 +42,10 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 		multiSigCmd.CreateMultiSigAccountCmd(mc.cdc),
 		multiSigCmd.UpdateMultiSigAccountCmd(mc.cdc),
 		multiSigCmd.TransferMultiSigAccountOwnershipCmd(mc.cdc),
+		multiSigCmd.CreateMultiSigTxCmd(mc.cdc),
+		multiSigCmd.SignMultiSigTxCmd(mc.cdc),
+		multiSigCmd.DeleteMultiSigTxCmd(mc.cdc),
+		multiSigCmd.RevokeMultiSigTxSignatureCmd(mc.cdc),
 	)...)
 
 	return txCmd
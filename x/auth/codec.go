@@ -14,6 +14,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateMultiSigTx{}, "mxw/msgCreateMultiSigTx", nil)
 
 	cdc.RegisterConcrete(MsgSignMultiSigTx{}, "mxw/msgSignMultiSigTx", nil)
+	cdc.RegisterConcrete(MsgDeleteMultiSigTx{}, "mxw/msgDeleteMultiSigTx", nil)
+	cdc.RegisterConcrete(MsgRevokeMultiSigTxSignature{}, "mxw/msgRevokeMultiSigTxSignature", nil)
+	cdc.RegisterConcrete(MsgSetMemoRequired{}, "mxw/msgSetMemoRequired", nil)
 
 }
 
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+)
+
+type GenesisState struct {
+	MemoRequiredAccounts []sdkTypes.AccAddress `json:"memo_required_accounts"`
+	MultiSigTxResults    []MultiSigTxResult    `json:"multisig_tx_results"`
+	MultiSigTxExpiries   []MultiSigTxExpiry    `json:"multisig_tx_expiries"`
+	SignerWeights        []SignerWeight        `json:"signer_weights"`
+	MultiSigPendingTxs   []MultiSigPendingTx   `json:"multisig_pending_txs"`
+}
+
+// MultiSigPendingTx is a pending tx of a multisig account. The accounts are exported without their pending txs,
+// since the txs can only be decoded by the app codec.
+type MultiSigPendingTx struct {
+	GroupAddress sdkTypes.AccAddress `json:"group_address"`
+	PendingTx    sdkTypes.PendingTx  `json:"pending_tx"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+func InitGenesis(ctx sdkTypes.Context, keeper *Keeper, accountKeeper sdkAuth.AccountKeeper, genesisState GenesisState) {
+	for _, addr := range genesisState.MemoRequiredAccounts {
+		keeper.SetMemoRequired(ctx, addr, true)
+	}
+
+	for _, result := range genesisState.MultiSigTxResults {
+		keeper.SetMultiSigTxResult(ctx, result)
+	}
+
+	for _, expiry := range genesisState.MultiSigTxExpiries {
+		keeper.SetMultiSigTxExpiry(ctx, expiry.GroupAddress, expiry.TxID, expiry.ExpiryHeight)
+	}
+
+	for _, signerWeight := range genesisState.SignerWeights {
+		keeper.setSignerWeight(ctx, signerWeight.GroupAddress, signerWeight.Signer, signerWeight.Weight)
+	}
+
+	for _, pendingTx := range genesisState.MultiSigPendingTxs {
+		groupAcc := accountKeeper.GetAccount(ctx, pendingTx.GroupAddress)
+		if groupAcc == nil || groupAcc.GetMultiSig() == nil {
+			panic(fmt.Sprintf("Invalid multisig account of pending tx: %s", pendingTx.GroupAddress))
+		}
+
+		multiSig := groupAcc.GetMultiSig()
+		if err := multiSig.AddTx(pendingTx.PendingTx); err != nil {
+			panic(err)
+		}
+		groupAcc.SetMultiSig(multiSig)
+		accountKeeper.SetAccount(ctx, groupAcc)
+	}
+}
+
+func ExportGenesis(ctx sdkTypes.Context, keeper *Keeper, accountKeeper sdkAuth.AccountKeeper) GenesisState {
+	var pendingTxs []MultiSigPendingTx
+	accountKeeper.IterateAccounts(ctx, func(acc exported.Account) bool {
+		if acc.GetMultiSig() == nil {
+			return false
+		}
+
+		for _, pendingTx := range acc.GetMultiSig().PendingTxs {
+			pendingTxs = append(pendingTxs, MultiSigPendingTx{acc.GetAddress(), pendingTx})
+		}
+
+		return false
+	})
+
+	return GenesisState{
+		MemoRequiredAccounts: keeper.ListMemoRequiredAccounts(ctx),
+		MultiSigTxResults:    keeper.ListAllMultiSigTxResults(ctx),
+		MultiSigTxExpiries:   keeper.ListAllMultiSigTxExpiries(ctx),
+		SignerWeights:        keeper.ListAllSignerWeights(ctx),
+		MultiSigPendingTxs:   pendingTxs,
+	}
+}
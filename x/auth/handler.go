@@ -3,37 +3,41 @@ package auth
 import (
 	"encoding/binary"
 	"fmt"
+	"strconv"
 
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/maxonrow/maxonrow-go/types"
 	"github.com/maxonrow/maxonrow-go/x/kyc"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/common"
-	rpc "github.com/tendermint/tendermint/rpc/core"
-	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
 	"golang.org/x/crypto/sha3"
 )
 
-// Internal broadcast tx
-//type RunMxwMsg func(ctx sdkTypes.Context, msg sdkTypes.Msg) (result sdkTypes.Result)
-//type RunMxwMsg func(ctx *rpctypes.Context, js string) (*ctypes.ResultBroadcastTx, error)
+// RunTx executes a pending multisig tx in the current block, as the group account.
+// It keeps the state changes only when the tx succeeds.
+type RunTx func(ctx sdkTypes.Context, groupAddress sdkTypes.AccAddress, tx sdkTypes.Tx) sdkTypes.Result
 
-func NewHandler(accountKeeper sdkAuth.AccountKeeper, kycKeeper kyc.Keeper, txEncoder sdkTypes.TxEncoder) sdkTypes.Handler {
+func NewHandler(accountKeeper sdkAuth.AccountKeeper, kycKeeper kyc.Keeper, keeper *Keeper, txEncoder sdkTypes.TxEncoder, runTx RunTx) sdkTypes.Handler {
 	return func(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.Result {
 		switch msg := msg.(type) {
 		case MsgCreateMultiSigAccount:
-			return handleMsgCreateMultiSigAccount(ctx, msg, accountKeeper, kycKeeper)
+			return handleMsgCreateMultiSigAccount(ctx, msg, accountKeeper, kycKeeper, keeper)
 		case MsgUpdateMultiSigAccount:
-			return handleMsgUpdateMultiSigAccount(ctx, msg, accountKeeper, kycKeeper)
+			return handleMsgUpdateMultiSigAccount(ctx, msg, accountKeeper, kycKeeper, keeper)
 		case MsgTransferMultiSigOwner:
 			return handleMsgTransferMultiSigOwner(ctx, msg, accountKeeper, kycKeeper)
 		case MsgCreateMultiSigTx:
-			return handleMsgCreateMultiSigTx(ctx, msg, accountKeeper, kycKeeper)
+			return handleMsgCreateMultiSigTx(ctx, msg, accountKeeper, kycKeeper, keeper)
 		case MsgSignMultiSigTx:
-			return handleMsgSignMultiSigTx(ctx, msg, accountKeeper, kycKeeper, txEncoder)
+			return handleMsgSignMultiSigTx(ctx, msg, accountKeeper, kycKeeper, keeper, txEncoder, runTx)
 		case MsgDeleteMultiSigTx:
-			return handleMsgDeleteMultiSigTx(ctx, msg, accountKeeper, kycKeeper)
+			return handleMsgDeleteMultiSigTx(ctx, msg, accountKeeper, kycKeeper, keeper)
+		case MsgRevokeMultiSigTxSignature:
+			return handleMsgRevokeMultiSigTxSignature(ctx, msg, accountKeeper, keeper)
+		case MsgSetMemoRequired:
+			return handleMsgSetMemoRequired(ctx, msg, accountKeeper, keeper)
 		default:
 			errMsg := "Unrecognized bank Msg type: %s" + msg.Type()
 			return sdkTypes.ErrUnknownRequest(errMsg).Result()
@@ -41,7 +45,7 @@ func NewHandler(accountKeeper sdkAuth.AccountKeeper, kycKeeper kyc.Keeper, txEnc
 	}
 }
 
-func handleMsgCreateMultiSigAccount(ctx sdkTypes.Context, msg MsgCreateMultiSigAccount, accountKeeper auth.AccountKeeper, kycKeeper kyc.Keeper) sdkTypes.Result {
+func handleMsgCreateMultiSigAccount(ctx sdkTypes.Context, msg MsgCreateMultiSigAccount, accountKeeper auth.AccountKeeper, kycKeeper kyc.Keeper, keeper *Keeper) sdkTypes.Result {
 	OwnerAcc := accountKeeper.GetAccount(ctx, msg.Owner)
 	if OwnerAcc == nil {
 		return sdkTypes.ErrInvalidAddress(fmt.Sprintf("Invalid account address: %s", msg.Owner)).Result()
@@ -62,6 +66,7 @@ func handleMsgCreateMultiSigAccount(ctx sdkTypes.Context, msg MsgCreateMultiSigA
 	multisig.Signers = msg.Signers
 	acc.SetMultiSig(multisig)
 	accountKeeper.SetAccount(ctx, acc)
+	keeper.SetSignerWeights(ctx, addr, msg.Signers, msg.Weights)
 
 	// TODO
 	// Whitelisted this address in kyc keeper.
@@ -92,7 +97,7 @@ func DeriveMultiSigAddress(addr sdkTypes.AccAddress, sequence uint64) sdkTypes.A
 	return sdkTypes.AccAddress(hash[12:])
 }
 
-func handleMsgUpdateMultiSigAccount(ctx sdkTypes.Context, msg MsgUpdateMultiSigAccount, accountKeeper auth.AccountKeeper, kycKeeper kyc.Keeper) sdkTypes.Result {
+func handleMsgUpdateMultiSigAccount(ctx sdkTypes.Context, msg MsgUpdateMultiSigAccount, accountKeeper auth.AccountKeeper, kycKeeper kyc.Keeper, keeper *Keeper) sdkTypes.Result {
 
 	groupAcc := accountKeeper.GetAccount(ctx, msg.GroupAddress)
 	if groupAcc == nil {
@@ -119,6 +124,7 @@ func handleMsgUpdateMultiSigAccount(ctx sdkTypes.Context, msg MsgUpdateMultiSigA
 	multiSig.Threshold = msg.NewThreshold
 	groupAcc.SetMultiSig(multiSig)
 	accountKeeper.SetAccount(ctx, groupAcc)
+	keeper.SetSignerWeights(ctx, msg.GroupAddress, msg.NewSigners, msg.NewWeights)
 
 	// TO-DO: event
 	accountSequence := ownerAccount.GetSequence()
@@ -169,7 +175,7 @@ func handleMsgTransferMultiSigOwner(ctx sdkTypes.Context, msg MsgTransferMultiSi
 
 }
 
-func handleMsgCreateMultiSigTx(ctx sdkTypes.Context, msg MsgCreateMultiSigTx, accountKeeper auth.AccountKeeper, kycKeeper kyc.Keeper) sdkTypes.Result {
+func handleMsgCreateMultiSigTx(ctx sdkTypes.Context, msg MsgCreateMultiSigTx, accountKeeper auth.AccountKeeper, kycKeeper kyc.Keeper, keeper *Keeper) sdkTypes.Result {
 
 	groupAcc := accountKeeper.GetAccount(ctx, msg.GroupAddress)
 	if groupAcc == nil {
@@ -185,6 +191,14 @@ func handleMsgCreateMultiSigTx(ctx sdkTypes.Context, msg MsgCreateMultiSigTx, ac
 		return sdkTypes.ErrUnknownRequest("Sender is not signer of group address.").Result()
 	}
 
+	if msg.ExpiryHeight != 0 && msg.ExpiryHeight <= ctx.BlockHeight() {
+		return sdkTypes.ErrUnknownRequest("Expiry height must be after the current height.").Result()
+	}
+
+	if !IsGroupTx(msg.StdTx, msg.GroupAddress) {
+		return sdkTypes.ErrUnauthorized("Pending tx must be signed by the group address only.").Result()
+	}
+
 	multiSig := groupAcc.GetMultiSig()
 	txID := multiSig.GetNewTxID()
 
@@ -199,6 +213,10 @@ func handleMsgCreateMultiSigTx(ctx sdkTypes.Context, msg MsgCreateMultiSigTx, ac
 	groupAcc.SetMultiSig(multiSig)
 	accountKeeper.SetAccount(ctx, groupAcc)
 
+	if msg.ExpiryHeight != 0 {
+		keeper.SetMultiSigTxExpiry(ctx, msg.GroupAddress, txID, msg.ExpiryHeight)
+	}
+
 	// TO-DO: event
 	accountSequence := senderAccount.GetSequence()
 	resultLog := types.NewResultLog(accountSequence, ctx.TxBytes())
@@ -213,7 +231,7 @@ func handleMsgCreateMultiSigTx(ctx sdkTypes.Context, msg MsgCreateMultiSigTx, ac
 
 }
 
-func handleMsgSignMultiSigTx(ctx sdkTypes.Context, msg MsgSignMultiSigTx, accountKeeper auth.AccountKeeper, kycKeeper kyc.Keeper, txEncoder sdkTypes.TxEncoder) sdkTypes.Result {
+func handleMsgSignMultiSigTx(ctx sdkTypes.Context, msg MsgSignMultiSigTx, accountKeeper auth.AccountKeeper, kycKeeper kyc.Keeper, keeper *Keeper, txEncoder sdkTypes.TxEncoder, runTx RunTx) sdkTypes.Result {
 
 	groupAcc := accountKeeper.GetAccount(ctx, msg.GroupAddress)
 	if groupAcc == nil {
@@ -232,68 +250,63 @@ func handleMsgSignMultiSigTx(ctx sdkTypes.Context, msg MsgSignMultiSigTx, accoun
 	multiSig := groupAcc.GetMultiSig()
 	multiSig.SignTx(msg.Sender, msg.TxID)
 
+	groupAcc.SetMultiSig(multiSig)
 	accountKeeper.SetAccount(ctx, groupAcc)
 
-	isMetric := multiSig.IsMetric(msg.TxID)
-	broadcastedEvents := sdkTypes.EmptyEvents()
-	var internalHash common.HexBytes
-	if isMetric {
-		// TO-DO: broadcast tx
-		tx := multiSig.GetTx(msg.TxID)
-		if tx == nil {
-			return sdkTypes.ErrInternal("There is no pending tx.").Result()
-		}
+	ok, pendingTx := multiSig.GetPendingTx(msg.TxID)
+	if !ok {
+		return sdkTypes.ErrUnknownRequest("Pending tx is not found.").Result()
+	}
+
+	accountSequence := senderAccount.GetSequence()
+	resultLog := types.NewResultLog(accountSequence, ctx.TxBytes())
 
-		bz, err := txEncoder(tx)
+	eventParam := []string{msg.Sender.String(), msg.GroupAddress.String(), strconv.FormatUint(msg.TxID, 10)}
+	eventSignature := "SignedMultiSigTx(string,string,string)"
+	events := types.MakeMxwEvents(eventSignature, msg.Sender.String(), eventParam)
+
+	if keeper.GetSignedWeight(ctx, msg.GroupAddress, multiSig, pendingTx) >= uint64(multiSig.Threshold) {
+		bz, err := txEncoder(pendingTx.Tx)
 		if err != nil {
 			return sdkTypes.ErrInternal("Error encoding pending tx.").Result()
 		}
-
-		var rpcCtx *rpctypes.Context
-		go func() {
-			res, err := rpc.BroadcastTxSync(rpcCtx, bz)
-			if err != nil {
-				panic(err)
+		internalHash := common.HexBytes(tmhash.Sum(bz))
+
+		// the pending tx runs in this block, a failed tx stays pending with its result
+		result := runTx(ctx.WithTxBytes(bz), msg.GroupAddress, pendingTx.Tx)
+		keeper.SetMultiSigTxResult(ctx, NewMultiSigTxResult(ctx, msg.GroupAddress, msg.TxID, internalHash, result))
+
+		if result.IsOK() {
+			// the pending tx can change the group account
+			groupAcc = accountKeeper.GetAccount(ctx, msg.GroupAddress)
+			multiSig = groupAcc.GetMultiSig()
+			isDeleted := multiSig.RemoveTx(msg.TxID)
+			if !isDeleted {
+				return sdkTypes.ErrUnknownRequest("Delete failed.").Result()
 			}
-			internalHash = res.Hash
-		}()
 
-		// Event: broadcast tx
-		broadcastedEventParam := []string{groupAcc.GetAddress().String(), string(msg.TxID)}
-		broadcastedEventSignature := "BroadcastedTx(string,string)"
-		broadcastedEvents = types.MakeMxwEvents(broadcastedEventSignature, groupAcc.GetAddress().String(), broadcastedEventParam)
+			groupAcc.SetMultiSig(multiSig)
+			accountKeeper.SetAccount(ctx, groupAcc)
+			keeper.DeleteMultiSigTxExpiry(ctx, msg.GroupAddress, msg.TxID)
 
-		isDeleted := multiSig.RemoveTx(msg.TxID)
-		if !isDeleted {
-			return sdkTypes.ErrUnknownRequest("Delete failed.").Result()
-		}
-		groupAcc = accountKeeper.GetAccount(ctx, msg.GroupAddress)
-		if groupAcc == nil {
-			return sdkTypes.ErrUnknownRequest("Group address invalid.").Result()
+			events = events.AppendEvents(result.Events)
 		}
 
-		groupAcc.SetMultiSig(multiSig)
-		accountKeeper.SetAccount(ctx, groupAcc)
-	}
-	accountSequence := senderAccount.GetSequence()
-	resultLog := types.NewResultLog(accountSequence, ctx.TxBytes())
+		executedEventParam := []string{groupAcc.GetAddress().String(), strconv.FormatUint(msg.TxID, 10), strconv.FormatBool(result.IsOK())}
+		executedEventSignature := "ExecutedMultiSigTx(string,string,bool)"
+		events = events.AppendEvents(types.MakeMxwEvents(executedEventSignature, groupAcc.GetAddress().String(), executedEventParam))
 
-	if internalHash != nil {
-		resultLog = resultLog.WithInternalHash(internalHash)
+		resultLog = resultLog.WithInternalHash(internalHash).WithInternalResult(uint32(result.Code), result.Log)
 	}
 
-	eventParam := []string{msg.Sender.String(), msg.GroupAddress.String(), string(msg.TxID)}
-	eventSignature := "SignedMultiSigTx(string,string,string)"
-	events := types.MakeMxwEvents(eventSignature, msg.Sender.String(), eventParam)
-
 	return sdkTypes.Result{
-		Events: events.AppendEvents(broadcastedEvents),
+		Events: events,
 		Log:    resultLog.String(),
 	}
 
 }
 
-func handleMsgDeleteMultiSigTx(ctx sdkTypes.Context, msg MsgDeleteMultiSigTx, accountKeeper auth.AccountKeeper, kycKeeper kyc.Keeper) sdkTypes.Result {
+func handleMsgDeleteMultiSigTx(ctx sdkTypes.Context, msg MsgDeleteMultiSigTx, accountKeeper auth.AccountKeeper, kycKeeper kyc.Keeper, keeper *Keeper) sdkTypes.Result {
 
 	groupAcc := accountKeeper.GetAccount(ctx, msg.GroupAddress)
 	if groupAcc == nil {
@@ -326,12 +339,13 @@ func handleMsgDeleteMultiSigTx(ctx sdkTypes.Context, msg MsgDeleteMultiSigTx, ac
 
 	groupAcc.SetMultiSig(multiSig)
 	accountKeeper.SetAccount(ctx, groupAcc)
+	keeper.DeleteMultiSigTxExpiry(ctx, msg.GroupAddress, msg.TxID)
 
 	// TO-DO: event
 	accountSequence := senderAccount.GetSequence()
 	resultLog := types.NewResultLog(accountSequence, ctx.TxBytes())
 
-	eventParam := []string{msg.Sender.String(), msg.GroupAddress.String(), string(msg.TxID)}
+	eventParam := []string{msg.Sender.String(), msg.GroupAddress.String(), strconv.FormatUint(msg.TxID, 10)}
 	eventSignature := "DeletedMultiSigTx(string,string,string)"
 
 	return sdkTypes.Result{
@@ -340,3 +354,77 @@ func handleMsgDeleteMultiSigTx(ctx sdkTypes.Context, msg MsgDeleteMultiSigTx, ac
 	}
 
 }
+
+// handleMsgRevokeMultiSigTxSignature lets a signer take back the signature of a tx which is still pending.
+func handleMsgRevokeMultiSigTxSignature(ctx sdkTypes.Context, msg MsgRevokeMultiSigTxSignature, accountKeeper auth.AccountKeeper, keeper *Keeper) sdkTypes.Result {
+
+	groupAcc := accountKeeper.GetAccount(ctx, msg.GroupAddress)
+	if groupAcc == nil || groupAcc.GetMultiSig() == nil {
+		return sdkTypes.ErrUnknownRequest("Group address invalid.").Result()
+	}
+
+	senderAccount := accountKeeper.GetAccount(ctx, msg.Sender)
+	if senderAccount == nil {
+		return sdkTypes.ErrUnknownRequest("Sender address invalid.").Result()
+	}
+
+	multiSig := groupAcc.GetMultiSig()
+	if !multiSig.IsSigner(msg.Sender) {
+		return sdkTypes.ErrUnknownRequest("Sender is not signer of group address.").Result()
+	}
+
+	index, ok := multiSig.ContainTx(msg.TxID)
+	if !ok {
+		return sdkTypes.ErrUnknownRequest("Pending tx is not found.").Result()
+	}
+
+	pendingTx := multiSig.PendingTxs[index]
+	if !hasSigned(pendingTx, msg.Sender) {
+		return sdkTypes.ErrUnknownRequest("Sender has not signed the pending tx.").Result()
+	}
+
+	var signedBy []sdkTypes.AccAddress
+	for _, signer := range pendingTx.SignedBy {
+		if !signer.Equals(msg.Sender) {
+			signedBy = append(signedBy, signer)
+		}
+	}
+	multiSig.PendingTxs[index].SignedBy = signedBy
+
+	groupAcc.SetMultiSig(multiSig)
+	accountKeeper.SetAccount(ctx, groupAcc)
+
+	// the last result was of the signers who reached the threshold, it is no longer the status of the tx
+	keeper.DeleteMultiSigTxResult(ctx, msg.GroupAddress, msg.TxID)
+
+	accountSequence := senderAccount.GetSequence()
+	resultLog := types.NewResultLog(accountSequence, ctx.TxBytes())
+
+	eventParam := []string{msg.Sender.String(), msg.GroupAddress.String(), strconv.FormatUint(msg.TxID, 10)}
+	eventSignature := "RevokedMultiSigTxSignature(string,string,string)"
+
+	return sdkTypes.Result{
+		Events: types.MakeMxwEvents(eventSignature, msg.Sender.String(), eventParam),
+		Log:    resultLog.String(),
+	}
+}
+
+func handleMsgSetMemoRequired(ctx sdkTypes.Context, msg MsgSetMemoRequired, accountKeeper auth.AccountKeeper, keeper *Keeper) sdkTypes.Result {
+	ownerAcc := accountKeeper.GetAccount(ctx, msg.Owner)
+	if ownerAcc == nil {
+		return sdkTypes.ErrInvalidAddress(fmt.Sprintf("Invalid account address: %s", msg.Owner)).Result()
+	}
+
+	keeper.SetMemoRequired(ctx, msg.Owner, msg.Required)
+
+	accountSequence := ownerAcc.GetSequence()
+	resultLog := types.NewResultLog(accountSequence, ctx.TxBytes())
+
+	eventParam := []string{msg.Owner.String(), strconv.FormatBool(msg.Required)}
+	eventSignature := "SetMemoRequired(string,bool)"
+
+	return sdkTypes.Result{
+		Events: types.MakeMxwEvents(eventSignature, msg.Owner.String(), eventParam),
+		Log:    resultLog.String(),
+	}
+}
@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+var prefixMemoRequired = []byte("0x01")
+
+func getMemoRequiredKey(addr sdkTypes.AccAddress) []byte {
+	return append(prefixMemoRequired, addr.Bytes()...)
+}
+
+// Keeper keeps the account settings that are not part of the account itself.
+type Keeper struct {
+	cdc *codec.Codec
+	key sdkTypes.StoreKey
+}
+
+func NewKeeper(cdc *codec.Codec, key sdkTypes.StoreKey) Keeper {
+	return Keeper{
+		cdc: cdc,
+		key: key,
+	}
+}
+
+// SetMemoRequired flags the account so transfers to it must have a memo.
+func (k *Keeper) SetMemoRequired(ctx sdkTypes.Context, addr sdkTypes.AccAddress, required bool) {
+	store := ctx.KVStore(k.key)
+	if required {
+		store.Set(getMemoRequiredKey(addr), []byte{1})
+	} else {
+		store.Delete(getMemoRequiredKey(addr))
+	}
+}
+
+func (k *Keeper) IsMemoRequired(ctx sdkTypes.Context, addr sdkTypes.AccAddress) bool {
+	store := ctx.KVStore(k.key)
+	return store.Has(getMemoRequiredKey(addr))
+}
+
+func (k *Keeper) ListMemoRequiredAccounts(ctx sdkTypes.Context) []sdkTypes.AccAddress {
+	store := ctx.KVStore(k.key)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixMemoRequired)
+	defer iter.Close()
+
+	var addrs []sdkTypes.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		addrs = append(addrs, sdkTypes.AccAddress(iter.Key()[len(prefixMemoRequired):]))
+	}
+
+	return addrs
+}
@@ -7,16 +7,19 @@ import (
 
 const RouterKey = "auth"
 
+// MsgCreateMultiSigAccount creates a group account, a tx of it runs once the weight of its signers reaches the threshold.
+// Weights are in the order of the signers, empty weights means every signer weighs 1.
 type MsgCreateMultiSigAccount struct {
 	Owner     sdkTypes.AccAddress   `json:"owner"`
 	Threshold int                   `json:"threshold"`
 	Signers   []sdkTypes.AccAddress `json:"signers"`
+	Weights   []uint64              `json:"weights,omitempty"`
 }
 
 var _ sdkTypes.Msg = MsgCreateMultiSigAccount{}
 
 func NewMsgCreateMultiSigAccount(master sdkTypes.AccAddress, threshold int, signers []sdkTypes.AccAddress) MsgCreateMultiSigAccount {
-	return MsgCreateMultiSigAccount{master, threshold, signers}
+	return MsgCreateMultiSigAccount{master, threshold, signers, nil}
 }
 
 func (msg MsgCreateMultiSigAccount) Route() string {
@@ -32,11 +35,7 @@ func (msg MsgCreateMultiSigAccount) ValidateBasic() sdkTypes.Error {
 		return sdkTypes.ErrInvalidAddress(msg.Owner.String())
 	}
 
-	if len(msg.Signers) < msg.Threshold {
-		return sdkTypes.ErrInternal("Invalid signers/ threshold signers: " + string(len(msg.Signers)) + " <  threshold: " + string(msg.Threshold))
-	}
-
-	return nil
+	return validateSignerWeights(msg.Signers, msg.Weights, msg.Threshold)
 }
 
 func (msg MsgCreateMultiSigAccount) GetSignBytes() []byte {
@@ -52,10 +51,11 @@ type MsgUpdateMultiSigAccount struct {
 	GroupAddress sdkTypes.AccAddress   `json:groupAddress`
 	NewThreshold int                   `json:threshold`
 	NewSigners   []sdkTypes.AccAddress `json:signers`
+	NewWeights   []uint64              `json:"newWeights,omitempty"`
 }
 
 func NewMsgUpdateMultiSigAccount(owner, groupAddress sdkTypes.AccAddress, threshold int, signers []sdkTypes.AccAddress) MsgUpdateMultiSigAccount {
-	return MsgUpdateMultiSigAccount{owner, groupAddress, threshold, signers}
+	return MsgUpdateMultiSigAccount{owner, groupAddress, threshold, signers, nil}
 }
 
 func (msg MsgUpdateMultiSigAccount) Route() string {
@@ -75,10 +75,7 @@ func (msg MsgUpdateMultiSigAccount) ValidateBasic() sdkTypes.Error {
 		return sdkTypes.ErrInvalidAddress(msg.GroupAddress.String())
 	}
 
-	if len(msg.NewSigners) < msg.NewThreshold {
-		return sdkTypes.ErrInternal("Invalid signers/ threshold signers: " + string(len(msg.NewSigners)) + " <  threshold: " + string(msg.NewThreshold))
-	}
-	return nil
+	return validateSignerWeights(msg.NewSigners, msg.NewWeights, msg.NewThreshold)
 }
 
 func (msg MsgUpdateMultiSigAccount) GetSignBytes() []byte {
@@ -130,16 +127,19 @@ func (msg MsgTransferMultiSigOwner) GetSigners() []sdkTypes.AccAddress {
 	return []sdkTypes.AccAddress{msg.Owner}
 }
 
+// MsgCreateMultiSigTx queues a tx of the group account for its signers.
+// Zero expiry height means the pending tx doesn't expire.
 type MsgCreateMultiSigTx struct {
 	GroupAddress sdkTypes.AccAddress `json:groupAddress`
 	StdTx        sdkTypes.Tx         `json:stdTx`
 	Sender       sdkTypes.AccAddress `json:sender`
+	ExpiryHeight int64               `json:"expiryHeight,omitempty"`
 }
 
 // start :
 // NewMsgCreateMultiSigTx :
 func NewMsgCreateMultiSigTx(groupAddress sdkTypes.AccAddress, tx auth.StdTx, sender sdkTypes.AccAddress) MsgCreateMultiSigTx {
-	return MsgCreateMultiSigTx{groupAddress, tx, sender}
+	return MsgCreateMultiSigTx{groupAddress, tx, sender, 0}
 }
 
 func (msg MsgCreateMultiSigTx) Route() string {
@@ -158,6 +158,10 @@ func (msg MsgCreateMultiSigTx) ValidateBasic() sdkTypes.Error {
 	if msg.GroupAddress.Empty() {
 		return sdkTypes.ErrInvalidAddress(msg.GroupAddress.String())
 	}
+
+	if msg.ExpiryHeight < 0 {
+		return sdkTypes.ErrUnknownRequest("Expiry height cant be negative.")
+	}
 	return nil
 }
 
@@ -250,3 +254,74 @@ func (msg MsgDeleteMultiSigTx) GetSignBytes() []byte {
 func (msg MsgDeleteMultiSigTx) GetSigners() []sdkTypes.AccAddress {
 	return []sdkTypes.AccAddress{msg.Sender}
 }
+
+// MsgRevokeMultiSigTxSignature removes the signature of the sender from a pending tx.
+type MsgRevokeMultiSigTxSignature struct {
+	GroupAddress sdkTypes.AccAddress `json:"groupAddress"`
+	TxID         uint64              `json:"txId"`
+	Sender       sdkTypes.AccAddress `json:"sender"`
+}
+
+func NewMsgRevokeMultiSigTxSignature(groupAddress sdkTypes.AccAddress, txID uint64, sender sdkTypes.AccAddress) MsgRevokeMultiSigTxSignature {
+	return MsgRevokeMultiSigTxSignature{groupAddress, txID, sender}
+}
+
+func (msg MsgRevokeMultiSigTxSignature) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRevokeMultiSigTxSignature) Type() string {
+	return "revokeMultiSigTxSignature"
+}
+
+func (msg MsgRevokeMultiSigTxSignature) ValidateBasic() sdkTypes.Error {
+	if msg.Sender.Empty() {
+		return sdkTypes.ErrInvalidAddress(msg.Sender.String())
+	}
+
+	if msg.GroupAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress(msg.GroupAddress.String())
+	}
+
+	return nil
+}
+
+func (msg MsgRevokeMultiSigTxSignature) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRevokeMultiSigTxSignature) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Sender}
+}
+
+type MsgSetMemoRequired struct {
+	Owner    sdkTypes.AccAddress `json:"owner"`
+	Required bool                `json:"required"`
+}
+
+func NewMsgSetMemoRequired(owner sdkTypes.AccAddress, required bool) MsgSetMemoRequired {
+	return MsgSetMemoRequired{owner, required}
+}
+
+func (msg MsgSetMemoRequired) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetMemoRequired) Type() string {
+	return "setMemoRequired"
+}
+
+func (msg MsgSetMemoRequired) ValidateBasic() sdkTypes.Error {
+	if msg.Owner.Empty() {
+		return sdkTypes.ErrInvalidAddress(msg.Owner.String())
+	}
+	return nil
+}
+
+func (msg MsgSetMemoRequired) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetMemoRequired) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Owner}
+}
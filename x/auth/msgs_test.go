@@ -2,6 +2,7 @@ package auth
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	//"github.com/cosmos/cosmos-sdk/codec"
@@ -109,3 +110,43 @@ func TestEncodeAmino(t *testing.T) {
 	assert.Equal(t, multiSigStdTx, *msg44)
 
 }
+
+func TestValidateSignerWeights(t *testing.T) {
+	addr1 := sdkTypes.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdkTypes.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr3 := sdkTypes.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	signers := []sdkTypes.AccAddress{addr1, addr2, addr3}
+
+	msg := NewMsgCreateMultiSigAccount(addr1, 3, signers)
+	assert.Nil(t, msg.ValidateBasic())
+
+	msg.Weights = []uint64{2, 1, 1}
+	msg.Threshold = 4
+	assert.Nil(t, msg.ValidateBasic())
+
+	msg.Threshold = 5
+	assert.NotNil(t, msg.ValidateBasic())
+
+	msg.Threshold = 3
+	msg.Weights = []uint64{2, 1}
+	assert.NotNil(t, msg.ValidateBasic())
+
+	msg.Weights = []uint64{3, 0, 1}
+	assert.NotNil(t, msg.ValidateBasic())
+
+	// a huge weight can't wrap the total around to reach the threshold
+	msg.Weights = []uint64{math.MaxUint64, math.MaxUint64, 5}
+	assert.NotNil(t, msg.ValidateBasic())
+	msg.Weights = []uint64{math.MaxUint64 - 2, 1, 1}
+	assert.Nil(t, msg.ValidateBasic())
+
+	msg.Weights = nil
+	msg.Signers = []sdkTypes.AccAddress{addr1, addr2, addr2}
+	assert.NotNil(t, msg.ValidateBasic())
+
+	update := NewMsgUpdateMultiSigAccount(addr1, addr1, 3, signers)
+	update.NewWeights = []uint64{1, 1, 1}
+	assert.Nil(t, update.ValidateBasic())
+	update.NewThreshold = 4
+	assert.NotNil(t, update.ValidateBasic())
+}
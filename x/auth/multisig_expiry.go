@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/maxonrow/maxonrow-go/types"
+)
+
+var prefixMultiSigTxExpiryQueue = []byte("0x03")
+var prefixMultiSigTxExpiry = []byte("0x04")
+
+func getMultiSigTxIDKey(groupAddress sdkTypes.AccAddress, txID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, txID)
+
+	return append(append([]byte{}, groupAddress.Bytes()...), bz...)
+}
+
+// the queue is keyed by height first, so the txs expired at a height are iterated in order.
+func getMultiSigTxExpiryQueueKey(height int64, groupAddress sdkTypes.AccAddress, txID uint64) []byte {
+	return append(getMultiSigTxExpiryQueueHeightKey(height), getMultiSigTxIDKey(groupAddress, txID)...)
+}
+
+func getMultiSigTxExpiryQueueHeightKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+
+	return append(append([]byte{}, prefixMultiSigTxExpiryQueue...), bz...)
+}
+
+func getMultiSigTxExpiryKey(groupAddress sdkTypes.AccAddress, txID uint64) []byte {
+	return append(append([]byte{}, prefixMultiSigTxExpiry...), getMultiSigTxIDKey(groupAddress, txID)...)
+}
+
+// MultiSigTxExpiry is the height a pending tx of a multisig account is removed at, unless it was executed before.
+type MultiSigTxExpiry struct {
+	GroupAddress sdkTypes.AccAddress `json:"group_address"`
+	TxID         uint64              `json:"tx_id"`
+	ExpiryHeight int64               `json:"expiry_height"`
+}
+
+func (k *Keeper) SetMultiSigTxExpiry(ctx sdkTypes.Context, groupAddress sdkTypes.AccAddress, txID uint64, expiryHeight int64) {
+	store := ctx.KVStore(k.key)
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(expiryHeight))
+
+	store.Set(getMultiSigTxExpiryKey(groupAddress, txID), bz)
+	store.Set(getMultiSigTxExpiryQueueKey(expiryHeight, groupAddress, txID), []byte{1})
+}
+
+// GetMultiSigTxExpiry returns the expiry height of the pending tx, zero if it doesn't expire.
+func (k *Keeper) GetMultiSigTxExpiry(ctx sdkTypes.Context, groupAddress sdkTypes.AccAddress, txID uint64) int64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(getMultiSigTxExpiryKey(groupAddress, txID))
+	if bz == nil {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func (k *Keeper) DeleteMultiSigTxExpiry(ctx sdkTypes.Context, groupAddress sdkTypes.AccAddress, txID uint64) {
+	expiryHeight := k.GetMultiSigTxExpiry(ctx, groupAddress, txID)
+	if expiryHeight == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Delete(getMultiSigTxExpiryKey(groupAddress, txID))
+	store.Delete(getMultiSigTxExpiryQueueKey(expiryHeight, groupAddress, txID))
+}
+
+func (k *Keeper) ListAllMultiSigTxExpiries(ctx sdkTypes.Context) []MultiSigTxExpiry {
+	store := ctx.KVStore(k.key)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixMultiSigTxExpiry)
+	defer iter.Close()
+
+	var expiries []MultiSigTxExpiry
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(prefixMultiSigTxExpiry):]
+		expiries = append(expiries, MultiSigTxExpiry{
+			GroupAddress: sdkTypes.AccAddress(key[:len(key)-8]),
+			TxID:         binary.BigEndian.Uint64(key[len(key)-8:]),
+			ExpiryHeight: int64(binary.BigEndian.Uint64(iter.Value())),
+		})
+	}
+
+	return expiries
+}
+
+// PruneExpiredMultiSigTxs removes the pending txs expired at the current height, it is called in EndBlock.
+func (k *Keeper) PruneExpiredMultiSigTxs(ctx sdkTypes.Context, accountKeeper sdkAuth.AccountKeeper) sdkTypes.Events {
+	store := ctx.KVStore(k.key)
+	iter := store.Iterator(getMultiSigTxExpiryQueueHeightKey(0), getMultiSigTxExpiryQueueHeightKey(ctx.BlockHeight()+1))
+
+	var expiries []MultiSigTxExpiry
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(prefixMultiSigTxExpiryQueue):]
+		expiries = append(expiries, MultiSigTxExpiry{
+			ExpiryHeight: int64(binary.BigEndian.Uint64(key[:8])),
+			GroupAddress: sdkTypes.AccAddress(key[8 : len(key)-8]),
+			TxID:         binary.BigEndian.Uint64(key[len(key)-8:]),
+		})
+	}
+	iter.Close()
+
+	events := sdkTypes.EmptyEvents()
+	for _, expiry := range expiries {
+		k.DeleteMultiSigTxExpiry(ctx, expiry.GroupAddress, expiry.TxID)
+
+		groupAcc := accountKeeper.GetAccount(ctx, expiry.GroupAddress)
+		if groupAcc == nil || groupAcc.GetMultiSig() == nil {
+			continue
+		}
+
+		multiSig := groupAcc.GetMultiSig()
+		if !multiSig.RemoveTx(expiry.TxID) {
+			continue
+		}
+		groupAcc.SetMultiSig(multiSig)
+		accountKeeper.SetAccount(ctx, groupAcc)
+
+		eventParam := []string{expiry.GroupAddress.String(), strconv.FormatUint(expiry.TxID, 10), strconv.FormatInt(expiry.ExpiryHeight, 10)}
+		eventSignature := "ExpiredMultiSigTx(string,string,string)"
+		events = events.AppendEvents(types.MakeMxwEvents(eventSignature, expiry.GroupAddress.String(), eventParam))
+	}
+
+	return events
+}
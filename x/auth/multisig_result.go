@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"encoding/binary"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+var prefixMultiSigTxResult = []byte("0x02")
+
+func getMultiSigTxResultKey(groupAddress sdkTypes.AccAddress, txID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, txID)
+
+	return append(append(append([]byte{}, prefixMultiSigTxResult...), groupAddress.Bytes()...), bz...)
+}
+
+// MultiSigTxResult is the result of executing a pending tx of a multisig account once it reached the threshold.
+// A failed tx stays pending, executing it again replaces its result.
+type MultiSigTxResult struct {
+	GroupAddress sdkTypes.AccAddress    `json:"group_address"`
+	TxID         uint64                 `json:"tx_id"`
+	Height       int64                  `json:"height"`
+	InternalHash common.HexBytes        `json:"internal_hash"`
+	Code         sdkTypes.CodeType      `json:"code"`
+	Codespace    sdkTypes.CodespaceType `json:"codespace,omitempty"`
+	Log          string                 `json:"log,omitempty"`
+	Events       sdkTypes.StringEvents  `json:"events,omitempty"`
+}
+
+func NewMultiSigTxResult(ctx sdkTypes.Context, groupAddress sdkTypes.AccAddress, txID uint64, internalHash common.HexBytes, result sdkTypes.Result) MultiSigTxResult {
+	return MultiSigTxResult{
+		GroupAddress: groupAddress,
+		TxID:         txID,
+		Height:       ctx.BlockHeight(),
+		InternalHash: internalHash,
+		Code:         result.Code,
+		Codespace:    result.Codespace,
+		Log:          result.Log,
+		Events:       sdkTypes.StringifyEvents(result.Events.ToABCIEvents()),
+	}
+}
+
+func (r MultiSigTxResult) IsOK() bool {
+	return r.Code.IsOK()
+}
+
+func (k *Keeper) SetMultiSigTxResult(ctx sdkTypes.Context, result MultiSigTxResult) {
+	store := ctx.KVStore(k.key)
+	store.Set(getMultiSigTxResultKey(result.GroupAddress, result.TxID), k.cdc.MustMarshalBinaryLengthPrefixed(result))
+}
+
+func (k *Keeper) GetMultiSigTxResult(ctx sdkTypes.Context, groupAddress sdkTypes.AccAddress, txID uint64) (*MultiSigTxResult, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(getMultiSigTxResultKey(groupAddress, txID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var result = new(MultiSigTxResult)
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, result)
+
+	return result, true
+}
+
+func (k *Keeper) DeleteMultiSigTxResult(ctx sdkTypes.Context, groupAddress sdkTypes.AccAddress, txID uint64) {
+	store := ctx.KVStore(k.key)
+	store.Delete(getMultiSigTxResultKey(groupAddress, txID))
+}
+
+func (k *Keeper) ListAllMultiSigTxResults(ctx sdkTypes.Context) []MultiSigTxResult {
+	store := ctx.KVStore(k.key)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixMultiSigTxResult)
+	defer iter.Close()
+
+	var results []MultiSigTxResult
+	for ; iter.Valid(); iter.Next() {
+		var result MultiSigTxResult
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &result)
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// PendingTxStatus is a pending tx of a multisig account with the signers who signed it and who still have to.
+// LastResult is the result of the last failed execution, if the tx reached the threshold before.
+type PendingTxStatus struct {
+	ID             uint64                `json:"id"`
+	Tx             sdkTypes.Tx           `json:"tx"`
+	Sender         sdkTypes.AccAddress   `json:"sender"`
+	SignedBy       []sdkTypes.AccAddress `json:"signed_by"`
+	PendingSigners []sdkTypes.AccAddress `json:"pending_signers"`
+	SignedWeight   uint64                `json:"signed_weight"`
+	Threshold      int                   `json:"threshold"`
+	ExpiryHeight   int64                 `json:"expiry_height,omitempty"`
+	LastResult     *MultiSigTxResult     `json:"last_result,omitempty"`
+}
+
+func (k *Keeper) getPendingTxStatus(ctx sdkTypes.Context, groupAddress sdkTypes.AccAddress, multiSig *sdkTypes.MultiSig, pendingTx sdkTypes.PendingTx) PendingTxStatus {
+	var pendingSigners []sdkTypes.AccAddress
+	for _, signer := range multiSig.Signers {
+		if !hasSigned(pendingTx, signer) {
+			pendingSigners = append(pendingSigners, signer)
+		}
+	}
+
+	lastResult, _ := k.GetMultiSigTxResult(ctx, groupAddress, pendingTx.ID)
+
+	return PendingTxStatus{
+		ID:             pendingTx.ID,
+		Tx:             pendingTx.Tx,
+		Sender:         pendingTx.Sender,
+		SignedBy:       pendingTx.SignedBy,
+		PendingSigners: pendingSigners,
+		SignedWeight:   k.GetSignedWeight(ctx, groupAddress, multiSig, pendingTx),
+		Threshold:      multiSig.Threshold,
+		ExpiryHeight:   k.GetMultiSigTxExpiry(ctx, groupAddress, pendingTx.ID),
+		LastResult:     lastResult,
+	}
+}
+
+func hasSigned(pendingTx sdkTypes.PendingTx, signer sdkTypes.AccAddress) bool {
+	for _, signedBy := range pendingTx.SignedBy {
+		if signedBy.Equals(signer) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ListPendingTxs returns the pending txs of the multisig account with their signer status.
+func (k *Keeper) ListPendingTxs(ctx sdkTypes.Context, accountKeeper sdkAuth.AccountKeeper, groupAddress sdkTypes.AccAddress) ([]PendingTxStatus, sdkTypes.Error) {
+	groupAcc := accountKeeper.GetAccount(ctx, groupAddress)
+	if groupAcc == nil || groupAcc.GetMultiSig() == nil {
+		return nil, sdkTypes.ErrUnknownRequest("Group address invalid.")
+	}
+
+	multiSig := groupAcc.GetMultiSig()
+	statuses := []PendingTxStatus{}
+	for _, pendingTx := range multiSig.PendingTxs {
+		statuses = append(statuses, k.getPendingTxStatus(ctx, groupAddress, multiSig, pendingTx))
+	}
+
+	return statuses, nil
+}
+
+func (k *Keeper) GetPendingTx(ctx sdkTypes.Context, accountKeeper sdkAuth.AccountKeeper, groupAddress sdkTypes.AccAddress, txID uint64) (*PendingTxStatus, sdkTypes.Error) {
+	groupAcc := accountKeeper.GetAccount(ctx, groupAddress)
+	if groupAcc == nil || groupAcc.GetMultiSig() == nil {
+		return nil, sdkTypes.ErrUnknownRequest("Group address invalid.")
+	}
+
+	multiSig := groupAcc.GetMultiSig()
+	ok, pendingTx := multiSig.GetPendingTx(txID)
+	if !ok {
+		return nil, sdkTypes.ErrUnknownRequest("Pending tx is not found.")
+	}
+
+	status := k.getPendingTxStatus(ctx, groupAddress, multiSig, pendingTx)
+	return &status, nil
+}
+
+// IsGroupTx tells if the group account is the only signer of the msgs of the tx,
+// a pending tx of a group account can't spend from any other account.
+func IsGroupTx(tx sdkTypes.Tx, groupAddress sdkTypes.AccAddress) bool {
+	if len(tx.GetMsgs()) == 0 {
+		return false
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(groupAddress) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -11,14 +12,26 @@ import (
 )
 
 const (
-	QueryMultiSigAcc = "get_multisig_acc"
+	QueryMultiSigAcc      = "get_multisig_acc"
+	QueryIsMemoRequired   = "is_memo_required"
+	QueryMultiSigTxResult = "get_multisig_tx_result"
+	QueryPendingTxs       = "list_multisig_pending_txs"
+	QueryPendingTx        = "get_multisig_pending_tx"
 )
 
-func NewQuerier(cdc *codec.Codec, accountKeeper sdkAuth.AccountKeeper) sdkTypes.Querier {
+func NewQuerier(cdc *codec.Codec, accountKeeper sdkAuth.AccountKeeper, keeper *Keeper) sdkTypes.Querier {
 	return func(ctx sdkTypes.Context, path []string, req abci.RequestQuery) ([]byte, sdkTypes.Error) {
 		switch path[0] {
 		case QueryMultiSigAcc:
 			return queryMultiSigAcc(cdc, ctx, path[1:], req, accountKeeper)
+		case QueryIsMemoRequired:
+			return queryIsMemoRequired(cdc, ctx, path[1:], req, keeper)
+		case QueryMultiSigTxResult:
+			return queryMultiSigTxResult(cdc, ctx, path[1:], req, keeper)
+		case QueryPendingTxs:
+			return queryPendingTxs(cdc, ctx, path[1:], req, accountKeeper, keeper)
+		case QueryPendingTx:
+			return queryPendingTx(cdc, ctx, path[1:], req, accountKeeper, keeper)
 		default:
 			return nil, sdkTypes.ErrUnknownRequest("unknown mxw/Auth query endpoint")
 		}
@@ -43,6 +56,95 @@ func queryMultiSigAcc(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ a
 	return respData, nil
 }
 
+func queryIsMemoRequired(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+
+	if len(path) != 1 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	addr, err := sdkTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownAddress(fmt.Sprintf("Invalid address %s", path[0]))
+	}
+
+	respData := cdc.MustMarshalJSON(keeper.IsMemoRequired(ctx, addr))
+
+	return respData, nil
+}
+
+func queryMultiSigTxResult(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+
+	if len(path) != 2 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	groupAddr, err := sdkTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownAddress(fmt.Sprintf("Invalid address %s", path[0]))
+	}
+
+	txID, err := strconv.ParseUint(path[1], 10, 64)
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid tx id %s", path[1]))
+	}
+
+	result, ok := keeper.GetMultiSigTxResult(ctx, groupAddr, txID)
+	if !ok {
+		return nil, sdkTypes.ErrUnknownRequest("Multisig tx was not executed.")
+	}
+
+	respData := cdc.MustMarshalJSON(result)
+
+	return respData, nil
+}
+
+func queryPendingTxs(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ abci.RequestQuery, accountKeeper sdkAuth.AccountKeeper, keeper *Keeper) ([]byte, sdkTypes.Error) {
+
+	if len(path) != 1 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	groupAddr, err := sdkTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownAddress(fmt.Sprintf("Invalid group address %s", path[0]))
+	}
+
+	pendingTxs, sdkErr := keeper.ListPendingTxs(ctx, accountKeeper, groupAddr)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	respData := cdc.MustMarshalJSON(pendingTxs)
+
+	return respData, nil
+}
+
+func queryPendingTx(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ abci.RequestQuery, accountKeeper sdkAuth.AccountKeeper, keeper *Keeper) ([]byte, sdkTypes.Error) {
+
+	if len(path) != 2 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	groupAddr, err := sdkTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownAddress(fmt.Sprintf("Invalid group address %s", path[0]))
+	}
+
+	txID, err := strconv.ParseUint(path[1], 10, 64)
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid tx id %s", path[1]))
+	}
+
+	pendingTx, sdkErr := keeper.GetPendingTx(ctx, accountKeeper, groupAddr, txID)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	respData := cdc.MustMarshalJSON(pendingTx)
+
+	return respData, nil
+}
+
 type GroupAccount struct {
 	
 }
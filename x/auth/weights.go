@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+var prefixSignerWeight = []byte("0x05")
+
+const maxWeight = math.MaxUint64
+
+func getSignerWeightKey(groupAddress, signer sdkTypes.AccAddress) []byte {
+	return append(getSignerWeightGroupKey(groupAddress), signer.Bytes()...)
+}
+
+func getSignerWeightGroupKey(groupAddress sdkTypes.AccAddress) []byte {
+	return append(append([]byte{}, prefixSignerWeight...), groupAddress.Bytes()...)
+}
+
+// SignerWeight is the weight of a signer of a multisig account, signers without one weigh 1.
+type SignerWeight struct {
+	GroupAddress sdkTypes.AccAddress `json:"group_address"`
+	Signer       sdkTypes.AccAddress `json:"signer"`
+	Weight       uint64              `json:"weight"`
+}
+
+// validateSignerWeights checks the signers can reach the threshold.
+// Empty weights means every signer weighs 1, otherwise there is a weight for each signer.
+func validateSignerWeights(signers []sdkTypes.AccAddress, weights []uint64, threshold int) sdkTypes.Error {
+	if threshold <= 0 {
+		return sdkTypes.ErrUnknownRequest("Threshold must be positive.")
+	}
+
+	if len(weights) != 0 && len(weights) != len(signers) {
+		return sdkTypes.ErrUnknownRequest("Every signer must have a weight.")
+	}
+
+	total := uint64(0)
+	for i, signer := range signers {
+		for _, other := range signers[:i] {
+			if signer.Equals(other) {
+				return sdkTypes.ErrUnknownRequest(fmt.Sprintf("Duplicated signer: %s", signer))
+			}
+		}
+
+		weight := uint64(1)
+		if len(weights) != 0 {
+			weight = weights[i]
+		}
+		if weight == 0 {
+			return sdkTypes.ErrUnknownRequest("Signer weight must be positive.")
+		}
+		// the signed weight of a pending tx is summed the same way, so the total must not overflow
+		if total > maxWeight-weight {
+			return sdkTypes.ErrUnknownRequest("Signer weights are too large.")
+		}
+		total += weight
+	}
+
+	if total < uint64(threshold) {
+		return sdkTypes.ErrUnknownRequest(fmt.Sprintf("Signers weigh %d in total, the threshold %d can never be reached.", total, threshold))
+	}
+
+	return nil
+}
+
+// SetSignerWeights replaces the weights of the signers of the multisig account.
+func (k *Keeper) SetSignerWeights(ctx sdkTypes.Context, groupAddress sdkTypes.AccAddress, signers []sdkTypes.AccAddress, weights []uint64) {
+	store := ctx.KVStore(k.key)
+
+	for _, signerWeight := range k.ListSignerWeights(ctx, groupAddress) {
+		store.Delete(getSignerWeightKey(groupAddress, signerWeight.Signer))
+	}
+
+	for i, weight := range weights {
+		k.setSignerWeight(ctx, groupAddress, signers[i], weight)
+	}
+}
+
+func (k *Keeper) setSignerWeight(ctx sdkTypes.Context, groupAddress, signer sdkTypes.AccAddress, weight uint64) {
+	store := ctx.KVStore(k.key)
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, weight)
+	store.Set(getSignerWeightKey(groupAddress, signer), bz)
+}
+
+func (k *Keeper) GetSignerWeight(ctx sdkTypes.Context, groupAddress, signer sdkTypes.AccAddress) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(getSignerWeightKey(groupAddress, signer))
+	if bz == nil {
+		return 1
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetSignedWeight returns the weight of the signers who signed the pending tx, each signer counts once.
+func (k *Keeper) GetSignedWeight(ctx sdkTypes.Context, groupAddress sdkTypes.AccAddress, multiSig *sdkTypes.MultiSig, pendingTx sdkTypes.PendingTx) uint64 {
+	weight := uint64(0)
+	for _, signer := range multiSig.Signers {
+		if hasSigned(pendingTx, signer) {
+			weight += k.GetSignerWeight(ctx, groupAddress, signer)
+		}
+	}
+
+	return weight
+}
+
+func (k *Keeper) ListSignerWeights(ctx sdkTypes.Context, groupAddress sdkTypes.AccAddress) []SignerWeight {
+	return k.listSignerWeights(ctx, getSignerWeightGroupKey(groupAddress))
+}
+
+func (k *Keeper) ListAllSignerWeights(ctx sdkTypes.Context) []SignerWeight {
+	return k.listSignerWeights(ctx, prefixSignerWeight)
+}
+
+func (k *Keeper) listSignerWeights(ctx sdkTypes.Context, prefix []byte) []SignerWeight {
+	store := ctx.KVStore(k.key)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	var signerWeights []SignerWeight
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(prefixSignerWeight):]
+		signerWeights = append(signerWeights, SignerWeight{
+			GroupAddress: sdkTypes.AccAddress(key[:sdkTypes.AddrLen]),
+			Signer:       sdkTypes.AccAddress(key[sdkTypes.AddrLen:]),
+			Weight:       binary.BigEndian.Uint64(iter.Value()),
+		})
+	}
+
+	return signerWeights
+}
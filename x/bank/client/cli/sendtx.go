@@ -2,6 +2,10 @@ package cli
 
 import (
 	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -9,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	sdkBank "github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/spf13/cobra"
 	"github.com/maxonrow/maxonrow-go/x/bank"
 )
@@ -45,3 +50,74 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// MultiSendTxCmd will create a multi send tx from a csv file of recipients and sign it with the given key.
+func MultiSendTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-send [from_key_or_address] [csv_file]",
+		Short: "Create and sign a send tx to many recipients",
+		Long: `Create and sign a send tx to many recipients read from a csv file.
+Every line of the file is a recipient address and an amount, lines starting with # are ignored:
+
+mxw1yw6mg7fty4mzcwupvzek53x5egm7tp2ldwaxq3,1000000cin`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			file, err := os.Open(args[1])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			outputs, err := ReadOutputsCSV(file)
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := bank.NewMsgMultiSend(cliCtx.GetFromAddress(), outputs)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// ReadOutputsCSV reads the outputs of a multi send, one address and amount per record.
+func ReadOutputsCSV(r io.Reader) ([]sdkBank.Output, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]sdkBank.Output, 0, len(records))
+	for i, record := range records {
+		to, err := sdk.AccAddressFromBech32(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("Invalid address on record %d: %s", i+1, err)
+		}
+
+		coins, err := sdk.ParseCoins(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("Invalid amount on record %d: %s", i+1, err)
+		}
+
+		outputs = append(outputs, sdkBank.NewOutput(to, coins))
+	}
+
+	return outputs, nil
+}
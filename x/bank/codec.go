@@ -7,6 +7,7 @@ import (
 // Register concrete types on codec codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgMxwSend{}, "mxw/msgSend", nil)
+	cdc.RegisterConcrete(MsgMxwMultiSend{}, "mxw/msgMultiSend", nil)
 }
 
 var msgCdc = codec.New()
@@ -7,12 +7,19 @@ import (
 	"github.com/maxonrow/maxonrow-go/types"
 )
 
+// TransferLimitKeeper records the transfers of an account against the transfer limits of its kyc tier.
+type TransferLimitKeeper interface {
+	ConsumeTransferLimit(ctx sdkTypes.Context, addr sdkTypes.AccAddress, denom string, amount sdkTypes.Uint) sdkTypes.Error
+}
+
 // NewHandler returns a handler for "bank" type messages.
-func NewHandler(k sdkBank.Keeper, accountKeeper sdkAuth.AccountKeeper) sdkTypes.Handler {
+func NewHandler(k sdkBank.Keeper, accountKeeper sdkAuth.AccountKeeper, transferLimitKeeper TransferLimitKeeper) sdkTypes.Handler {
 	return func(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.Result {
 		switch msg := msg.(type) {
 		case MsgMxwSend:
-			return handleMsgSend(ctx, k, msg, accountKeeper)
+			return handleMsgSend(ctx, k, msg, accountKeeper, transferLimitKeeper)
+		case MsgMxwMultiSend:
+			return handleMsgMultiSend(ctx, k, msg, accountKeeper, transferLimitKeeper)
 		default:
 			errMsg := "Unrecognized bank Msg type: %s" + msg.Type()
 			return sdkTypes.ErrUnknownRequest(errMsg).Result()
@@ -21,10 +28,13 @@ func NewHandler(k sdkBank.Keeper, accountKeeper sdkAuth.AccountKeeper) sdkTypes.
 }
 
 // Handle MsgSend.
-func handleMsgSend(ctx sdkTypes.Context, k sdkBank.Keeper, msg MsgMxwSend, accountKeeper sdkAuth.AccountKeeper) sdkTypes.Result {
+func handleMsgSend(ctx sdkTypes.Context, k sdkBank.Keeper, msg MsgMxwSend, accountKeeper sdkAuth.AccountKeeper, transferLimitKeeper TransferLimitKeeper) sdkTypes.Result {
 	if !k.GetSendEnabled(ctx) {
 		return sdkBank.ErrSendDisabled(k.Codespace()).Result()
 	}
+	if err := consumeTransferLimits(ctx, transferLimitKeeper, msg.FromAddress, msg.Amount); err != nil {
+		return err.Result()
+	}
 	err := k.SendCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
 	if err != nil {
 		return err.Result()
@@ -34,6 +44,43 @@ func handleMsgSend(ctx sdkTypes.Context, k sdkBank.Keeper, msg MsgMxwSend, accou
 
 }
 
+// Handle MsgMultiSend, one Transferred event is emitted per output.
+func handleMsgMultiSend(ctx sdkTypes.Context, k sdkBank.Keeper, msg MsgMxwMultiSend, accountKeeper sdkAuth.AccountKeeper, transferLimitKeeper TransferLimitKeeper) sdkTypes.Result {
+	if !k.GetSendEnabled(ctx) {
+		return sdkBank.ErrSendDisabled(k.Codespace()).Result()
+	}
+
+	var result sdkTypes.Result
+	for _, out := range msg.Outputs {
+		if err := consumeTransferLimits(ctx, transferLimitKeeper, msg.FromAddress, out.Coins); err != nil {
+			return err.Result()
+		}
+
+		err := k.SendCoins(ctx, msg.FromAddress, out.Address, out.Coins)
+		if err != nil {
+			return err.Result()
+		}
+
+		sendResult := MakeBankSendEvent(ctx, msg.FromAddress, out.Address, out.Coins, accountKeeper)
+		result.Events = result.Events.AppendEvents(sendResult.Events)
+		result.Log = sendResult.Log
+	}
+
+	return result
+}
+
+// consumeTransferLimits records the coins sent by the account, it runs in the msg context so that a failed msg doesn't
+// count towards the limits.
+func consumeTransferLimits(ctx sdkTypes.Context, transferLimitKeeper TransferLimitKeeper, from sdkTypes.AccAddress, coins sdkTypes.Coins) sdkTypes.Error {
+	for _, coin := range coins {
+		if err := transferLimitKeeper.ConsumeTransferLimit(ctx, from, coin.Denom, sdkTypes.NewUintFromBigInt(coin.Amount.BigInt())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func MakeBankSendEvent(ctx sdkTypes.Context, fromAddress sdkTypes.AccAddress, toAddress sdkTypes.AccAddress, amount sdkTypes.Coins, accountKeeper sdkAuth.AccountKeeper) sdkTypes.Result {
 
 	ownerWalletAccount := accountKeeper.GetAccount(ctx, fromAddress)
@@ -49,3 +49,64 @@ func (msg *MsgMxwSend) UnmarshalJSON(data []byte) error {
 func (msg MsgMxwSend) GetSignBytes() []byte {
 	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
 }
+
+// MsgMxwMultiSend - transfer from one sender to many outputs, like a payroll run.
+type MsgMxwMultiSend struct {
+	FromAddress sdk.AccAddress   `json:"from_address"`
+	Outputs     []sdkBank.Output `json:"outputs"`
+}
+
+var _ sdk.Msg = MsgMxwMultiSend{}
+
+// NewMsgMultiSend - construct a send msg from one sender to many outputs.
+func NewMsgMultiSend(fromAddr sdk.AccAddress, outputs []sdkBank.Output) MsgMxwMultiSend {
+	return MsgMxwMultiSend{
+		FromAddress: fromAddr,
+		Outputs:     outputs,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgMxwMultiSend) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgMxwMultiSend) Type() string { return "multiSend" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgMxwMultiSend) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+
+	if len(msg.Outputs) == 0 {
+		return sdkBank.ErrNoOutputs(sdkBank.DefaultCodespace)
+	}
+
+	for _, out := range msg.Outputs {
+		if err := out.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgMxwMultiSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgMxwMultiSend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// Total returns the sum of the outputs.
+func (msg MsgMxwMultiSend) Total() sdk.Coins {
+	var total sdk.Coins
+	for _, out := range msg.Outputs {
+		total = total.Add(out.Coins)
+	}
+
+	return total
+}
@@ -29,7 +29,7 @@ func NewQuerier(feeKeeper fee.Keeper) sdkTypes.Querier {
 }
 
 func queryGetTransferFee(ctx sdkTypes.Context, path []string, req abci.RequestQuery, feeKeeper fee.Keeper) ([]byte, sdkTypes.Error) {
-	if len(path) != 2 {
+	if len(path) != 2 && len(path) != 3 {
 		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
 	}
 
@@ -40,17 +40,22 @@ func queryGetTransferFee(ctx sdkTypes.Context, path []string, req abci.RequestQu
 		return nil, sdkTypes.ErrInvalidCoins("Invalid amount")
 	}
 
-	feeSetting, feeSettingErr := feeKeeper.GetMsgFeeSetting(ctx, msgType)
-	if feeSettingErr != nil {
-		return nil, feeSettingErr
+	// optional signer, the account fee setting of the signer overrides the msg fee setting
+	var signer sdkTypes.AccAddress
+	if len(path) == 3 {
+		var err error
+		signer, err = sdkTypes.AccAddressFromBech32(path[2])
+		if err != nil {
+			return nil, sdkTypes.ErrInvalidAddress(path[2])
+		}
 	}
 
-	calculatedFee, err := fee.DefaultCalculateFee(ctx, feeSetting, totalAmount)
+	quote, err := feeKeeper.QuoteFee(ctx, fee.NewFeeRequest(msgType, signer, totalAmount))
 	if err != nil {
 		return nil, err
 	}
 
-	respData := sdkTypes.MustSortJSON(codec.Cdc.MustMarshalJSON(calculatedFee))
+	respData := sdkTypes.MustSortJSON(codec.Cdc.MustMarshalJSON(quote.FeeAmount()))
 
 	return respData, nil
 
@@ -157,3 +157,220 @@ func GetTokenFeeMultiplier(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func GetFeeDistribution(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution",
+		Short: "get fee distribution",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", "fee", fee.QueryFeeDistribution), nil)
+			if err != nil {
+				fmt.Printf("Could not get fee distribution: %s\n", err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func GetDistributedFees(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distributed [last|total]",
+		Short: "get fees distributed in the last distribution or in total",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var route string
+			switch args[0] {
+			case "last":
+				route = fee.QueryLastDistributed
+			case "total":
+				route = fee.QueryTotalDistributed
+			default:
+				return fmt.Errorf("Invalid argument %s, expected last or total", args[0])
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", "fee", route), nil)
+			if err != nil {
+				fmt.Printf("Could not get distributed fees: %s\n", err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func GetFeeToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-token [symbol]",
+		Short: "get the conversion rate of a token accepted to pay fees",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", "fee", fee.QueryFeeToken, args[0]), nil)
+			if err != nil {
+				fmt.Printf("Could not get fee token: %s\n", err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func ListFeeTokens(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-tokens",
+		Short: "list the tokens accepted to pay fees",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", "fee", fee.QueryListFeeTokens), nil)
+			if err != nil {
+				fmt.Printf("Could not list fee tokens: %s\n", err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func GetFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowance [granter] [grantee]",
+		Short: "get the fee allowance granted by the granter to the grantee",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", "fee", fee.QueryFeeAllowance, args[0], args[1]), nil)
+			if err != nil {
+				fmt.Printf("Could not get fee allowance: %s\n", err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func ListFeeAllowances(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowances [grantee]",
+		Short: "list the fee allowances granted to the grantee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", "fee", fee.QueryListFeeAllowances, args[0]), nil)
+			if err != nil {
+				fmt.Printf("Could not list fee allowances: %s\n", err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func ListScheduledFeeChanges(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-changes",
+		Short: "list the fee setting and multiplier changes waiting for their effective height",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", "fee", fee.QueryScheduledChanges), nil)
+			if err != nil {
+				fmt.Printf("Could not list scheduled fee changes: %s\n", err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func GetFeeSettingAtHeight(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-setting-at [name] [height]",
+		Short: "get the fee setting as it was at the block height",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", "fee", fee.QueryFeeSettingAt, args[0], args[1]), nil)
+			if err != nil {
+				fmt.Printf("Could not get fee setting: %s\n", err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func ListFeeChanges(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-changes [from-height] [to-height]",
+		Short: "list the fee setting, assignment and multiplier changes made between the block heights",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", "fee", fee.QueryFeeChanges, args[0], args[1]), nil)
+			if err != nil {
+				fmt.Printf("Could not list fee changes: %s\n", err)
+				return nil
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"time"
 
 	"github.com/maxonrow/maxonrow-go/types"
 	"github.com/maxonrow/maxonrow-go/x/fee"
@@ -71,7 +72,14 @@ func CreateMsgSysFeeSetting(cdc *codec.Codec) *cobra.Command {
 			}
 			maxcoin := sdkTypes.NewCoin(types.CIN, amtMax)
 
+			brackets, err := getFeeBrackets()
+			if err != nil {
+				return err
+			}
+
 			msg := fee.NewMsgSysFeeSetting(name, sdkTypes.Coins{mincoin}, sdkTypes.Coins{maxcoin}, percentageStr, issuer)
+			msg.EffectiveHeight = viper.GetInt64("effective-height")
+			msg.Brackets = brackets
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -84,10 +92,26 @@ func CreateMsgSysFeeSetting(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String("min", "200000000cin", "minimum fee")
 	cmd.Flags().String("max", "1000000000cin", "maximum fee")
 	cmd.Flags().String("percentage", "0.05", "percentage example: 10% = 10, 0.1% = 0.1")
+	cmd.Flags().Int64("effective-height", 0, "block height the change takes effect at, 0 to take effect right away")
+	cmd.Flags().StringSlice("brackets", nil, "tiered fee brackets in threshold=fee format, fee is a percentage or a flat amount, example: 1000000cin=0.1,=5000cin")
 
 	return cmd
 }
 
+// getFeeBrackets parses the brackets flag, the last bracket has no threshold.
+func getFeeBrackets() ([]fee.FeeBracket, error) {
+	var brackets []fee.FeeBracket
+	for _, str := range viper.GetStringSlice("brackets") {
+		bracket, err := fee.ParseFeeBracket(str)
+		if err != nil {
+			return nil, err
+		}
+		brackets = append(brackets, bracket)
+	}
+
+	return brackets, nil
+}
+
 func EditMsgSysFeeSetting(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "edit-sysfee",
@@ -128,7 +152,14 @@ func EditMsgSysFeeSetting(cdc *codec.Codec) *cobra.Command {
 			}
 			maxcoin := sdkTypes.NewCoin(types.CIN, amtMax)
 
+			brackets, err := getFeeBrackets()
+			if err != nil {
+				return err
+			}
+
 			msg := fee.NewMsgSysFeeSetting(name, sdkTypes.Coins{mincoin}, sdkTypes.Coins{maxcoin}, percentageStr, issuer)
+			msg.EffectiveHeight = viper.GetInt64("effective-height")
+			msg.Brackets = brackets
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -141,6 +172,8 @@ func EditMsgSysFeeSetting(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String("min", "200000000cin", "minimum fee")
 	cmd.Flags().String("max", "1000000000cin", "maximum fee")
 	cmd.Flags().String("percentage", "0.05", "percentage example: 10% = 10, 0.1% = 0.1")
+	cmd.Flags().Int64("effective-height", 0, "block height the change takes effect at, 0 to take effect right away")
+	cmd.Flags().StringSlice("brackets", nil, "tiered fee brackets in threshold=fee format, fee is a percentage or a flat amount, example: 1000000cin=0.1,=5000cin")
 
 	return cmd
 }
@@ -359,6 +392,7 @@ func CreateFeeMultiplier(cdc *codec.Codec) *cobra.Command {
 			issuer := cliCtx.GetFromAddress()
 
 			msg := fee.NewMsgMultiplier(multiplier, issuer)
+			msg.EffectiveHeight = viper.GetInt64("effective-height")
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -367,6 +401,8 @@ func CreateFeeMultiplier(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Int64("effective-height", 0, "block height the change takes effect at, 0 to take effect right away")
+
 	return cmd
 }
 
@@ -386,6 +422,155 @@ func CreateTokenFeeMultiplier(cdc *codec.Codec) *cobra.Command {
 			issuer := cliCtx.GetFromAddress()
 
 			msg := fee.NewMsgTokenMultiplier(multiplier, issuer)
+			msg.EffectiveHeight = viper.GetInt64("effective-height")
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64("effective-height", 0, "block height the change takes effect at, 0 to take effect right away")
+
+	return cmd
+}
+
+func SetFeeToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-token [token-symbol] [rate] [collector]",
+		Short: "Accept a fungible token to pay fees, rate is the token amount charged for one cin of fee",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authTypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			tokenSymbol := args[0]
+			rate := args[1]
+
+			collector, err := sdkTypes.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			tokenData, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/token/token_data/%s", tokenSymbol), nil)
+			if tokenData == nil {
+				return fmt.Errorf("No such token symbol.")
+			}
+
+			issuer := cliCtx.GetFromAddress()
+
+			msg := fee.NewMsgSetFeeToken(tokenSymbol, rate, collector, issuer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func DeleteFeeToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-fee-token [token-symbol]",
+		Short: "Stop accepting a fungible token to pay fees",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authTypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			issuer := cliCtx.GetFromAddress()
+
+			msg := fee.NewMsgDeleteFeeToken(args[0], issuer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func GrantFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-allowance [grantee]",
+		Short: "Grant an allowance to pay the fees of the grantee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authTypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			grantee, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdkTypes.ParseCoins(viper.GetString("spend-limit"))
+			if err != nil {
+				return err
+			}
+
+			perTxLimit, err := sdkTypes.ParseCoins(viper.GetString("per-tx-limit"))
+			if err != nil {
+				return err
+			}
+
+			var expiration time.Time
+			if expirationStr := viper.GetString("expiration"); expirationStr != "" {
+				expiration, err = time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			granter := cliCtx.GetFromAddress()
+
+			msg := fee.NewMsgGrantFeeAllowance(granter, grantee, spendLimit, perTxLimit, expiration, viper.GetStringSlice("msg-types"))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdkTypes.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String("spend-limit", "", "total fees the allowance can pay, empty for no limit")
+	cmd.Flags().String("per-tx-limit", "", "fee the allowance can pay for a single tx, empty for no limit")
+	cmd.Flags().String("expiration", "", "expiration time of the allowance in RFC3339 format, empty for no expiration")
+	cmd.Flags().StringSlice("msg-types", nil, "msg types the allowance pays for, example: bank-send, empty for any")
+
+	return cmd
+}
+
+func RevokeFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-allowance [grantee]",
+		Short: "Revoke the allowance granted to the grantee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authTypes.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			grantee, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			granter := cliCtx.GetFromAddress()
+
+			msg := fee.NewMsgRevokeFeeAllowance(granter, grantee)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
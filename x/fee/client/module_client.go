@@ -29,6 +29,15 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 		feeCmd.GetFeeMultiplier(mc.cdc),
 		feeCmd.GetTokenFeeMultiplier(mc.cdc),
 		feeCmd.GetAccFeeSetting(mc.cdc),
+		feeCmd.GetFeeDistribution(mc.cdc),
+		feeCmd.GetDistributedFees(mc.cdc),
+		feeCmd.GetFeeToken(mc.cdc),
+		feeCmd.ListFeeTokens(mc.cdc),
+		feeCmd.GetFeeAllowance(mc.cdc),
+		feeCmd.ListFeeAllowances(mc.cdc),
+		feeCmd.ListScheduledFeeChanges(mc.cdc),
+		feeCmd.GetFeeSettingAtHeight(mc.cdc),
+		feeCmd.ListFeeChanges(mc.cdc),
 	)...)
 
 	return queryCmd
@@ -50,6 +59,10 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 		feeCmd.CreateTokenFeeMultiplier(mc.cdc),
 		feeCmd.SetTokenFeeSetting(mc.cdc),
 		feeCmd.CreateMsgDeleteAccountFeeSetting(mc.cdc),
+		feeCmd.SetFeeToken(mc.cdc),
+		feeCmd.DeleteFeeToken(mc.cdc),
+		feeCmd.GrantFeeAllowance(mc.cdc),
+		feeCmd.RevokeFeeAllowance(mc.cdc),
 		//feeCmd.AddSysFeeSetting(mc.cdc),
 	)...)
 
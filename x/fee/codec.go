@@ -11,6 +11,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgTokenMultiplier{}, "fee/msgTokenMultiplier", nil)
 	cdc.RegisterConcrete(MsgDeleteSysFeeSetting{}, "fee/deleteSysFeeSetting", nil)
 	cdc.RegisterConcrete(MsgDeleteAccFeeSetting{}, "fee/deleteAccFeeSetting", nil)
+	cdc.RegisterConcrete(MsgSetFeeToken{}, "fee/setFeeToken", nil)
+	cdc.RegisterConcrete(MsgDeleteFeeToken{}, "fee/deleteFeeToken", nil)
+	cdc.RegisterConcrete(MsgGrantFeeAllowance{}, "fee/grantFeeAllowance", nil)
+	cdc.RegisterConcrete(MsgRevokeFeeAllowance{}, "fee/revokeFeeAllowance", nil)
+	cdc.RegisterConcrete(MsgUseFeeAllowance{}, "fee/useFeeAllowance", nil)
 }
 
 var msgCdc = codec.New()
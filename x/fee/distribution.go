@@ -0,0 +1,284 @@
+package fee
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
+	supplyExported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+	"github.com/maxonrow/maxonrow-go/types"
+)
+
+// ModuleName of the module account used to burn fees.
+const ModuleName = "fee"
+
+var prefixFeeDistribution = []byte("0x07")
+var prefixLastFeeDistribution = []byte("0x08")
+var prefixTotalFeeDistribution = []byte("0x09")
+
+// FeeDistribution splits the fees collected in a block between validators, treasury, burn
+// and the module fee collectors. Shares are percentages of the block fees and must add up to 100.
+// Rounding leftovers and shares of modules without fee collector go to the validators.
+type FeeDistribution struct {
+	Validators      string              `json:"validators"`
+	Treasury        string              `json:"treasury"`
+	TreasuryAddress sdkTypes.AccAddress `json:"treasury_address"`
+	Burn            string              `json:"burn"`
+	Collectors      []ModuleFeeShare    `json:"collectors"`
+}
+
+type ModuleFeeShare struct {
+	Module     string `json:"module"`
+	Percentage string `json:"percentage"`
+}
+
+// FeeDistributionResult is the amounts distributed by the fee distribution.
+type FeeDistributionResult struct {
+	Height     int64                `json:"height"`
+	Validators sdkTypes.Coins       `json:"validators"`
+	Treasury   sdkTypes.Coins       `json:"treasury"`
+	Burned     sdkTypes.Coins       `json:"burned"`
+	Collectors []ModuleFeeCollected `json:"collectors"`
+}
+
+type ModuleFeeCollected struct {
+	Module  string              `json:"module"`
+	Address sdkTypes.AccAddress `json:"address"`
+	Amount  sdkTypes.Coins      `json:"amount"`
+}
+
+type SupplyKeeper interface {
+	GetModuleAccount(ctx sdkTypes.Context, moduleName string) supplyExported.ModuleAccountI
+	SendCoinsFromModuleToAccount(ctx sdkTypes.Context, senderModule string, recipientAddr sdkTypes.AccAddress, amt sdkTypes.Coins) sdkTypes.Error
+	SendCoinsFromModuleToModule(ctx sdkTypes.Context, senderModule, recipientModule string, amt sdkTypes.Coins) sdkTypes.Error
+	BurnCoins(ctx sdkTypes.Context, moduleName string, amt sdkTypes.Coins) sdkTypes.Error
+	HasSupply(ctx sdkTypes.Context) bool
+}
+
+func parseShare(percentage string) (sdkTypes.Dec, sdkTypes.Error) {
+	if percentage == "" {
+		return sdkTypes.ZeroDec(), nil
+	}
+
+	share, err := sdkTypes.NewDecFromStr(percentage)
+	if err != nil || share.IsNegative() {
+		return sdkTypes.Dec{}, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid fee distribution percentage: %s", percentage))
+	}
+
+	return share, nil
+}
+
+func (d FeeDistribution) ValidateBasic() sdkTypes.Error {
+	total := sdkTypes.ZeroDec()
+
+	for _, percentage := range []string{d.Validators, d.Treasury, d.Burn} {
+		share, err := parseShare(percentage)
+		if err != nil {
+			return err
+		}
+		total = total.Add(share)
+	}
+
+	modules := make(map[string]bool)
+	for _, collector := range d.Collectors {
+		if collector.Module == "" || modules[collector.Module] {
+			return sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid fee distribution module: %s", collector.Module))
+		}
+		modules[collector.Module] = true
+
+		share, err := parseShare(collector.Percentage)
+		if err != nil {
+			return err
+		}
+		total = total.Add(share)
+	}
+
+	if !total.Equal(sdkTypes.NewDec(100)) {
+		return sdkTypes.ErrUnknownRequest(fmt.Sprintf("Fee distribution percentages must add up to 100, got %s", total))
+	}
+
+	treasury, _ := parseShare(d.Treasury)
+	if treasury.IsPositive() && d.TreasuryAddress.Empty() {
+		return sdkTypes.ErrInvalidAddress("Treasury address is required.")
+	}
+
+	return nil
+}
+
+func (k *Keeper) SetFeeDistribution(ctx sdkTypes.Context, distribution FeeDistribution) sdkTypes.Error {
+	err := distribution.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Set(prefixFeeDistribution, k.cdc.MustMarshalBinaryLengthPrefixed(distribution))
+
+	return nil
+}
+
+func (k *Keeper) GetFeeDistribution(ctx sdkTypes.Context) (*FeeDistribution, sdkTypes.Error) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(prefixFeeDistribution)
+	if bz == nil {
+		return nil, sdkTypes.ErrUnknownRequest("No fee distribution found")
+	}
+
+	var distribution = new(FeeDistribution)
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, distribution)
+
+	return distribution, nil
+}
+
+func (k *Keeper) GetLastFeeDistribution(ctx sdkTypes.Context) (*FeeDistributionResult, sdkTypes.Error) {
+	return k.getFeeDistributionResult(ctx, prefixLastFeeDistribution)
+}
+
+func (k *Keeper) GetTotalFeeDistribution(ctx sdkTypes.Context) (*FeeDistributionResult, sdkTypes.Error) {
+	return k.getFeeDistributionResult(ctx, prefixTotalFeeDistribution)
+}
+
+func (k *Keeper) getFeeDistributionResult(ctx sdkTypes.Context, key []byte) (*FeeDistributionResult, sdkTypes.Error) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(key)
+	if bz == nil {
+		return nil, sdkTypes.ErrUnknownRequest("No fee distributed yet")
+	}
+
+	var result = new(FeeDistributionResult)
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, result)
+
+	return result, nil
+}
+
+func (k *Keeper) storeFeeDistributionResult(ctx sdkTypes.Context, result FeeDistributionResult) {
+	store := ctx.KVStore(k.key)
+	store.Set(prefixLastFeeDistribution, k.cdc.MustMarshalBinaryLengthPrefixed(result))
+
+	total, err := k.GetTotalFeeDistribution(ctx)
+	if err != nil {
+		total = &FeeDistributionResult{}
+	}
+	total.Height = result.Height
+	total.Validators = total.Validators.Add(result.Validators)
+	total.Treasury = total.Treasury.Add(result.Treasury)
+	total.Burned = total.Burned.Add(result.Burned)
+	for _, collected := range result.Collectors {
+		found := false
+		for i, totalCollected := range total.Collectors {
+			if totalCollected.Module == collected.Module && totalCollected.Address.Equals(collected.Address) {
+				total.Collectors[i].Amount = totalCollected.Amount.Add(collected.Amount)
+				found = true
+				break
+			}
+		}
+		if !found {
+			total.Collectors = append(total.Collectors, collected)
+		}
+	}
+
+	store.Set(prefixTotalFeeDistribution, k.cdc.MustMarshalBinaryLengthPrefixed(total))
+}
+
+func shareOf(amount sdkTypes.Int, percentage string) sdkTypes.Int {
+	share, err := parseShare(percentage)
+	if err != nil {
+		return sdkTypes.ZeroInt()
+	}
+
+	return amount.ToDec().Mul(share).Quo(sdkTypes.NewDec(100)).TruncateInt()
+}
+
+// DistributeFees splits the fees collected in the fee collector module account by the fee distribution.
+// The validators share is left in the fee collector for the distribution module.
+// Nothing is done when there is no fee distribution.
+func (k *Keeper) DistributeFees(ctx sdkTypes.Context, supplyKeeper SupplyKeeper) (sdkTypes.Events, sdkTypes.Error) {
+	distribution, err := k.GetFeeDistribution(ctx)
+	if err != nil {
+		return nil, nil
+	}
+
+	feeCollector := supplyKeeper.GetModuleAccount(ctx, sdkAuth.FeeCollectorName)
+	fees := feeCollector.GetCoins().AmountOf(types.CIN)
+	if fees.IsZero() {
+		return nil, nil
+	}
+
+	// burning needs the total supply, which the chains started before it was tracked don't have
+	burn := shareOf(fees, distribution.Burn)
+	if burn.IsPositive() && !supplyKeeper.HasSupply(ctx) {
+		return nil, sdkTypes.ErrInternal("Total supply is not stored, fees cannot be burned.")
+	}
+
+	var result = FeeDistributionResult{
+		Height: ctx.BlockHeight(),
+	}
+	var events sdkTypes.Events
+	owner := feeCollector.GetAddress().String()
+	distributed := sdkTypes.ZeroInt()
+
+	treasury := shareOf(fees, distribution.Treasury)
+	if treasury.IsPositive() {
+		amt := sdkTypes.NewCoins(sdkTypes.NewCoin(types.CIN, treasury))
+		err := supplyKeeper.SendCoinsFromModuleToAccount(ctx, sdkAuth.FeeCollectorName, distribution.TreasuryAddress, amt)
+		if err != nil {
+			return nil, err
+		}
+		result.Treasury = amt
+		distributed = distributed.Add(treasury)
+	}
+
+	if burn.IsPositive() {
+		amt := sdkTypes.NewCoins(sdkTypes.NewCoin(types.CIN, burn))
+		err := supplyKeeper.SendCoinsFromModuleToModule(ctx, sdkAuth.FeeCollectorName, ModuleName, amt)
+		if err != nil {
+			return nil, err
+		}
+		err = supplyKeeper.BurnCoins(ctx, ModuleName, amt)
+		if err != nil {
+			return nil, err
+		}
+		result.Burned = amt
+		distributed = distributed.Add(burn)
+	}
+
+	// module shares are split evenly between the fee collectors of the module
+	for _, collector := range distribution.Collectors {
+		addresses := k.GetFeeCollectorAddresses(ctx, collector.Module)
+		if len(addresses) == 0 {
+			continue
+		}
+
+		share := shareOf(fees, collector.Percentage).QuoRaw(int64(len(addresses)))
+		if !share.IsPositive() {
+			continue
+		}
+
+		amt := sdkTypes.NewCoins(sdkTypes.NewCoin(types.CIN, share))
+		for _, address := range addresses {
+			err := supplyKeeper.SendCoinsFromModuleToAccount(ctx, sdkAuth.FeeCollectorName, address, amt)
+			if err != nil {
+				return nil, err
+			}
+			result.Collectors = append(result.Collectors, ModuleFeeCollected{
+				Module:  collector.Module,
+				Address: address,
+				Amount:  amt,
+			})
+			distributed = distributed.Add(share)
+
+			eventParam := []string{collector.Module, address.String(), amt.String()}
+			eventSignature := "DistributedModuleFee(string,string,string)"
+			events = events.AppendEvents(types.MakeMxwEvents(eventSignature, owner, eventParam))
+		}
+	}
+
+	result.Validators = sdkTypes.NewCoins(sdkTypes.NewCoin(types.CIN, fees.Sub(distributed)))
+	k.storeFeeDistributionResult(ctx, result)
+
+	eventParam := []string{result.Validators.String(), result.Treasury.String(), result.Burned.String()}
+	eventSignature := "DistributedFees(string,string,string)"
+	events = events.AppendEvents(types.MakeMxwEvents(eventSignature, owner, eventParam))
+
+	return events, nil
+}
@@ -0,0 +1,176 @@
+package fee
+
+import (
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maxonrow/maxonrow-go/types"
+)
+
+// Fee setting sources, in the order they override each other.
+const (
+	FeeSourceDefault = "default"
+	FeeSourceMsg     = "msg"
+	FeeSourceToken   = "token"
+	FeeSourceAccount = "account"
+)
+
+// FeeRequest describes what a single msg is charged for.
+// Symbol and Action are only set for token actions, Outputs only for multi sends.
+type FeeRequest struct {
+	MsgType string
+	Signer  sdkTypes.AccAddress
+	Symbol  string
+	Action  string
+	Amount  sdkTypes.Coins
+	Outputs []sdkTypes.Coins
+}
+
+// FeeQuote explains how the fee of a single msg was derived.
+type FeeQuote struct {
+	MsgType    string         `json:"msg_type"`
+	Source     string         `json:"source"`
+	FeeSetting string         `json:"fee_setting"`
+	Percentage string         `json:"percentage"`
+	Amount     sdkTypes.Coins `json:"amount"`
+	RawFee     sdkTypes.Coins `json:"raw_fee"`
+	Min        sdkTypes.Coins `json:"min"`
+	Max        sdkTypes.Coins `json:"max"`
+	Multiplier string         `json:"multiplier"`
+	Fee        sdkTypes.Coins `json:"fee"`
+}
+
+func NewFeeRequest(msgType string, signer sdkTypes.AccAddress, amount sdkTypes.Coins) FeeRequest {
+	return FeeRequest{
+		MsgType: msgType,
+		Signer:  signer,
+		Amount:  amount,
+	}
+}
+
+// NewMultiSendFeeRequest charges every output of a multi send, the amount is their total.
+func NewMultiSendFeeRequest(msgType string, signer sdkTypes.AccAddress, outputs []sdkTypes.Coins) FeeRequest {
+	var total sdkTypes.Coins
+	for _, output := range outputs {
+		total = total.Add(output)
+	}
+
+	return FeeRequest{
+		MsgType: msgType,
+		Signer:  signer,
+		Amount:  total,
+		Outputs: outputs,
+	}
+}
+
+func NewTokenFeeRequest(msgType string, signer sdkTypes.AccAddress, symbol, action string, amount sdkTypes.Coins) FeeRequest {
+	return FeeRequest{
+		MsgType: msgType,
+		Signer:  signer,
+		Symbol:  symbol,
+		Action:  action,
+		Amount:  amount,
+	}
+}
+
+// QuoteFee resolves the fee setting of the request and calculates its fee.
+// The fee setting is resolved in this order: account, token action, msg type and default.
+// Token actions use the token fee multiplier, everything else uses the fee multiplier.
+func (k *Keeper) QuoteFee(ctx sdkTypes.Context, req FeeRequest) (FeeQuote, sdkTypes.Error) {
+	store := ctx.KVStore(k.key)
+
+	var feeSetting *FeeSetting
+	var multiplier string
+	var source string
+	var err sdkTypes.Error
+
+	if req.Symbol != "" && store.Has(getTokenFeeSettingKey(req.Symbol, req.Action)) {
+		feeSetting, err = k.GetTokenFeeSetting(ctx, req.Symbol, req.Action)
+		if err != nil {
+			return FeeQuote{}, err
+		}
+
+		multiplier, err = k.GetTokenFeeMultiplier(ctx)
+		if err != nil {
+			return FeeQuote{}, sdkTypes.ErrInternal("Get fee multiplier failed.")
+		}
+		source = FeeSourceToken
+	} else {
+		source = FeeSourceDefault
+		if store.Has(getMsgFeeSettingKey(req.MsgType)) {
+			source = FeeSourceMsg
+		}
+
+		feeSetting, err = k.GetMsgFeeSetting(ctx, req.MsgType)
+		if err != nil {
+			return FeeQuote{}, err
+		}
+
+		multiplier, err = k.GetFeeMultiplier(ctx)
+		if err != nil {
+			return FeeQuote{}, sdkTypes.ErrInternal("Get fee multiplier failed.")
+		}
+	}
+
+	// if account have fee setting overwrite it.
+	if !req.Signer.Empty() {
+		accFeeSetting, _ := k.GetAccFeeSetting(ctx, req.Signer)
+		if accFeeSetting != nil {
+			feeSetting = accFeeSetting
+			source = FeeSourceAccount
+		}
+	}
+
+	var fee sdkTypes.Coins
+	rawFee := calculateRawFee(feeSetting, req.Amount)
+	if len(req.Outputs) > 0 {
+		fee, err = CalculateMultiSendFee(ctx, feeSetting, multiplier, req.Outputs)
+		rawFee = calculateRawMultiSendFee(feeSetting, req.Outputs)
+	} else {
+		fee, err = CalculateFee(ctx, feeSetting, multiplier, req.Amount)
+	}
+	if err != nil {
+		return FeeQuote{}, err
+	}
+
+	// drop zero coins, so the quote always equals the sum charged by the ante handler
+	fee = sdkTypes.Coins{}.Add(fee)
+
+	return FeeQuote{
+		MsgType:    req.MsgType,
+		Source:     source,
+		FeeSetting: feeSetting.Name,
+		Percentage: feeSetting.Percentage,
+		Amount:     req.Amount,
+		RawFee:     rawFee,
+		Min:        feeSetting.Min,
+		Max:        feeSetting.Max,
+		Multiplier: multiplier,
+		Fee:        fee,
+	}, nil
+}
+
+// FeeAmount returns the fee of the quote, or zero cin when there is no fee to pay.
+func (q FeeQuote) FeeAmount() sdkTypes.Coins {
+	if q.Fee.Empty() {
+		return sdkTypes.Coins{sdkTypes.NewCoin(types.CIN, sdkTypes.ZeroInt())}
+	}
+
+	return q.Fee
+}
+
+// QuoteFees quotes every request and returns the quotes with their total.
+func (k *Keeper) QuoteFees(ctx sdkTypes.Context, reqs []FeeRequest) ([]FeeQuote, sdkTypes.Coins, sdkTypes.Error) {
+	quotes := make([]FeeQuote, 0, len(reqs))
+
+	var fees sdkTypes.Coins
+	for _, req := range reqs {
+		quote, err := k.QuoteFee(ctx, req)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		quotes = append(quotes, quote)
+		fees = fees.Add(quote.Fee)
+	}
+
+	return quotes, fees, nil
+}
@@ -0,0 +1,222 @@
+package fee
+
+import (
+	"fmt"
+	"time"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maxonrow/maxonrow-go/types"
+)
+
+var prefixFeeAllowance = []byte("0x0B")
+
+// allowances are keyed by grantee first, so the allowances of a grantee can be listed.
+func getFeeAllowanceKey(grantee, granter sdkTypes.AccAddress) []byte {
+	return append(getFeeAllowanceGranteeKey(grantee), granter.Bytes()...)
+}
+
+func getFeeAllowanceGranteeKey(grantee sdkTypes.AccAddress) []byte {
+	return append(prefixFeeAllowance, grantee.Bytes()...)
+}
+
+// FeeAllowance lets the granter pay the fees of the grantee.
+// SpendLimit is what is left to spend and PerTxLimit caps the fee of a single tx, empty means no cap.
+// Zero expiration means the allowance doesn't expire and empty msg types allows any msg.
+type FeeAllowance struct {
+	Granter    sdkTypes.AccAddress `json:"granter"`
+	Grantee    sdkTypes.AccAddress `json:"grantee"`
+	SpendLimit sdkTypes.Coins      `json:"spend_limit"`
+	PerTxLimit sdkTypes.Coins      `json:"per_tx_limit"`
+	Expiration time.Time           `json:"expiration"`
+	MsgTypes   []string            `json:"msg_types"`
+}
+
+func (a FeeAllowance) ValidateBasic() sdkTypes.Error {
+	if a.Granter.Empty() {
+		return sdkTypes.ErrInvalidAddress(a.Granter.String())
+	}
+
+	if a.Grantee.Empty() {
+		return sdkTypes.ErrInvalidAddress(a.Grantee.String())
+	}
+
+	if a.Granter.Equals(a.Grantee) {
+		return sdkTypes.ErrInvalidAddress("Granter cant grant fee allowance to itself.")
+	}
+
+	if !a.SpendLimit.IsValid() {
+		return sdkTypes.ErrInvalidCoins(a.SpendLimit.String())
+	}
+
+	if !a.PerTxLimit.IsValid() {
+		return sdkTypes.ErrInvalidCoins(a.PerTxLimit.String())
+	}
+
+	for _, msgType := range a.MsgTypes {
+		if len(msgType) <= 0 {
+			return sdkTypes.ErrUnknownRequest("Msg type cant be empty.")
+		}
+	}
+
+	return nil
+}
+
+func (a FeeAllowance) isExpired(blockTime time.Time) bool {
+	return !a.Expiration.IsZero() && !blockTime.Before(a.Expiration)
+}
+
+func (a FeeAllowance) isMsgTypeAllowed(msgType string) bool {
+	if len(a.MsgTypes) == 0 {
+		return true
+	}
+
+	for _, allowed := range a.MsgTypes {
+		if allowed == msgType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (k *Keeper) GrantFeeAllowance(
+	ctx sdkTypes.Context,
+	msgGrantFeeAllowance MsgGrantFeeAllowance,
+) sdkTypes.Result {
+
+	allowance := FeeAllowance{
+		Granter:    msgGrantFeeAllowance.Granter,
+		Grantee:    msgGrantFeeAllowance.Grantee,
+		SpendLimit: msgGrantFeeAllowance.SpendLimit,
+		PerTxLimit: msgGrantFeeAllowance.PerTxLimit,
+		Expiration: msgGrantFeeAllowance.Expiration,
+		MsgTypes:   msgGrantFeeAllowance.MsgTypes,
+	}
+
+	if allowance.isExpired(ctx.BlockHeader().Time) {
+		return sdkTypes.ErrUnknownRequest("Fee allowance already expired.").Result()
+	}
+
+	err := k.storeFeeAllowance(ctx, allowance)
+	if err != nil {
+		return err.Result()
+	}
+
+	eventParam := []string{allowance.Granter.String(), allowance.Grantee.String()}
+	eventSignature := "GrantedFeeAllowance(string,string)"
+
+	return sdkTypes.Result{
+		Events: types.MakeMxwEvents(eventSignature, msgGrantFeeAllowance.GetSigners()[0].String(), eventParam),
+	}
+}
+
+func (k *Keeper) RevokeFeeAllowance(
+	ctx sdkTypes.Context,
+	msgRevokeFeeAllowance MsgRevokeFeeAllowance,
+) sdkTypes.Result {
+
+	store := ctx.KVStore(k.key)
+	key := getFeeAllowanceKey(msgRevokeFeeAllowance.Grantee, msgRevokeFeeAllowance.Granter)
+	if !store.Has(key) {
+		return sdkTypes.ErrUnknownRequest("No such fee allowance.").Result()
+	}
+
+	store.Delete(key)
+
+	eventParam := []string{msgRevokeFeeAllowance.Granter.String(), msgRevokeFeeAllowance.Grantee.String()}
+	eventSignature := "RevokedFeeAllowance(string,string)"
+
+	return sdkTypes.Result{
+		Events: types.MakeMxwEvents(eventSignature, msgRevokeFeeAllowance.GetSigners()[0].String(), eventParam),
+	}
+}
+
+func (k *Keeper) storeFeeAllowance(ctx sdkTypes.Context, allowance FeeAllowance) sdkTypes.Error {
+	err := allowance.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Set(getFeeAllowanceKey(allowance.Grantee, allowance.Granter), k.cdc.MustMarshalBinaryLengthPrefixed(allowance))
+
+	return nil
+}
+
+func (k *Keeper) GetFeeAllowance(ctx sdkTypes.Context, granter, grantee sdkTypes.AccAddress) (*FeeAllowance, sdkTypes.Error) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(getFeeAllowanceKey(grantee, granter))
+	if bz == nil {
+		return nil, sdkTypes.ErrUnknownRequest("No such fee allowance.")
+	}
+
+	var allowance = new(FeeAllowance)
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, allowance)
+
+	return allowance, nil
+}
+
+func (k *Keeper) ListFeeAllowances(ctx sdkTypes.Context, grantee sdkTypes.AccAddress) []FeeAllowance {
+	return k.listFeeAllowances(ctx, getFeeAllowanceGranteeKey(grantee))
+}
+
+func (k *Keeper) ListAllFeeAllowances(ctx sdkTypes.Context) []FeeAllowance {
+	return k.listFeeAllowances(ctx, prefixFeeAllowance)
+}
+
+func (k *Keeper) listFeeAllowances(ctx sdkTypes.Context, prefix []byte) []FeeAllowance {
+	store := ctx.KVStore(k.key)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	var allowances []FeeAllowance
+	for ; iter.Valid(); iter.Next() {
+		var allowance FeeAllowance
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &allowance)
+		allowances = append(allowances, allowance)
+	}
+
+	return allowances
+}
+
+// UseFeeAllowance checks the allowance can pay the fee of the msg types and takes the fee off the allowance.
+// The fee itself has to be deducted from the granter by the caller.
+func (k *Keeper) UseFeeAllowance(ctx sdkTypes.Context, granter, grantee sdkTypes.AccAddress, fee sdkTypes.Coins, msgTypes []string) sdkTypes.Error {
+	allowance, err := k.GetFeeAllowance(ctx, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	if allowance.isExpired(ctx.BlockHeader().Time) {
+		return sdkTypes.ErrUnauthorized("Fee allowance expired.")
+	}
+
+	for _, msgType := range msgTypes {
+		if !allowance.isMsgTypeAllowed(msgType) {
+			return sdkTypes.ErrUnauthorized(fmt.Sprintf("Fee allowance doesn't allow %s.", msgType))
+		}
+	}
+
+	if !allowance.PerTxLimit.Empty() && !fee.IsAllLTE(allowance.PerTxLimit) {
+		return sdkTypes.ErrInsufficientFee(fmt.Sprintf("Fee exceeds the per tx limit of fee allowance: %s", allowance.PerTxLimit))
+	}
+
+	if !allowance.SpendLimit.Empty() {
+		left, negative := allowance.SpendLimit.SafeSub(fee)
+		if negative {
+			return sdkTypes.ErrInsufficientFee(fmt.Sprintf("Fee exceeds the spend limit of fee allowance: %s", allowance.SpendLimit))
+		}
+
+		// an exhausted allowance is removed
+		if left.IsZero() {
+			store := ctx.KVStore(k.key)
+			store.Delete(getFeeAllowanceKey(grantee, granter))
+			return nil
+		}
+
+		allowance.SpendLimit = left
+		return k.storeFeeAllowance(ctx, *allowance)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package fee
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maxonrow/maxonrow-go/types"
+)
+
+var prefixFeeToken = []byte("0x0A")
+
+func getFeeTokenKey(symbol string) []byte {
+	return append(prefixFeeToken, []byte(symbol)...)
+}
+
+// FeeToken is a fungible token accepted to pay transaction fees.
+// Rate is the amount of token charged for one cin of fee, the token paid goes to the collector.
+type FeeToken struct {
+	Symbol    string              `json:"symbol"`
+	Rate      string              `json:"rate"`
+	Collector sdkTypes.AccAddress `json:"collector"`
+}
+
+func NewFeeToken(symbol, rate string, collector sdkTypes.AccAddress) FeeToken {
+	return FeeToken{
+		Symbol:    symbol,
+		Rate:      rate,
+		Collector: collector,
+	}
+}
+
+func (t FeeToken) ValidateBasic() sdkTypes.Error {
+	if len(t.Symbol) <= 0 {
+		return sdkTypes.ErrInvalidCoins("Symbol cant be empty.")
+	}
+
+	if t.Symbol == types.CIN {
+		return sdkTypes.ErrInvalidCoins("Fee token cant be cin.")
+	}
+
+	rate, err := sdkTypes.NewDecFromStr(t.Rate)
+	if err != nil {
+		return err
+	}
+
+	if !rate.IsPositive() {
+		return sdkTypes.ErrInternal("Rate invalid.")
+	}
+
+	if t.Collector.Empty() {
+		return sdkTypes.ErrInvalidAddress("Fee token collector cant be empty.")
+	}
+
+	return nil
+}
+
+// Convert returns the fee in cin converted to the token, rounded up so the fee is never underpaid.
+func (t FeeToken) Convert(fees sdkTypes.Coins) (sdkTypes.Coins, sdkTypes.Error) {
+	rate, err := sdkTypes.NewDecFromStr(t.Rate)
+	if err != nil {
+		return nil, err
+	}
+
+	amt := fees.AmountOf(types.CIN).ToDec().Mul(rate).Ceil().TruncateInt()
+
+	// token symbols are not valid coin denoms, the coin can't be created by NewCoin
+	return sdkTypes.Coins{}.Add(sdkTypes.Coins{sdkTypes.Coin{Denom: t.Symbol, Amount: amt}}), nil
+}
+
+func (k *Keeper) SetFeeToken(
+	ctx sdkTypes.Context,
+	msgSetFeeToken MsgSetFeeToken,
+) sdkTypes.Result {
+
+	if !k.IsAuthorised(ctx, msgSetFeeToken.Issuer) {
+		return sdkTypes.ErrUnknownRequest("Not authorised to set fee token.").Result()
+	}
+
+	feeToken := NewFeeToken(msgSetFeeToken.Symbol, msgSetFeeToken.Rate, msgSetFeeToken.Collector)
+	err := k.storeFeeToken(ctx, feeToken)
+	if err != nil {
+		return err.Result()
+	}
+
+	eventParam := []string{feeToken.Symbol, feeToken.Rate, feeToken.Collector.String()}
+	eventSignature := "SetFeeToken(string,string,string)"
+
+	return sdkTypes.Result{
+		Events: types.MakeMxwEvents(eventSignature, msgSetFeeToken.GetSigners()[0].String(), eventParam),
+	}
+}
+
+func (k *Keeper) DeleteFeeToken(
+	ctx sdkTypes.Context,
+	msgDeleteFeeToken MsgDeleteFeeToken,
+) sdkTypes.Result {
+
+	if !k.IsAuthorised(ctx, msgDeleteFeeToken.Issuer) {
+		return sdkTypes.ErrUnknownRequest("Not authorised to delete fee token.").Result()
+	}
+
+	if !k.IsFeeToken(ctx, msgDeleteFeeToken.Symbol) {
+		return sdkTypes.ErrUnknownRequest(fmt.Sprintf("%s is not a fee token.", msgDeleteFeeToken.Symbol)).Result()
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Delete(getFeeTokenKey(msgDeleteFeeToken.Symbol))
+
+	eventParam := []string{msgDeleteFeeToken.Symbol}
+	eventSignature := "DeletedFeeToken(string)"
+
+	return sdkTypes.Result{
+		Events: types.MakeMxwEvents(eventSignature, msgDeleteFeeToken.GetSigners()[0].String(), eventParam),
+	}
+}
+
+func (k *Keeper) storeFeeToken(ctx sdkTypes.Context, feeToken FeeToken) sdkTypes.Error {
+	err := feeToken.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Set(getFeeTokenKey(feeToken.Symbol), k.cdc.MustMarshalBinaryLengthPrefixed(feeToken))
+
+	return nil
+}
+
+func (k *Keeper) IsFeeToken(ctx sdkTypes.Context, symbol string) bool {
+	store := ctx.KVStore(k.key)
+	return store.Has(getFeeTokenKey(symbol))
+}
+
+func (k *Keeper) GetFeeToken(ctx sdkTypes.Context, symbol string) (*FeeToken, sdkTypes.Error) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(getFeeTokenKey(symbol))
+	if bz == nil {
+		return nil, sdkTypes.ErrInvalidCoins(fmt.Sprintf("%s is not accepted to pay fees.", symbol))
+	}
+
+	var feeToken = new(FeeToken)
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, feeToken)
+
+	return feeToken, nil
+}
+
+func (k *Keeper) ListAllFeeTokens(ctx sdkTypes.Context) []FeeToken {
+	store := ctx.KVStore(k.key)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixFeeToken)
+	defer iter.Close()
+
+	var feeTokens []FeeToken
+	for ; iter.Valid(); iter.Next() {
+		var feeToken FeeToken
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &feeToken)
+		feeTokens = append(feeTokens, feeToken)
+	}
+
+	return feeTokens
+}
+
+// ConvertFee converts the fee in cin to the fee token.
+func (k *Keeper) ConvertFee(ctx sdkTypes.Context, fees sdkTypes.Coins, symbol string) (sdkTypes.Coins, sdkTypes.Error) {
+	feeToken, err := k.GetFeeToken(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	return feeToken.Convert(fees)
+}
@@ -6,10 +6,19 @@ import (
 )
 
 type GenesisState struct {
-	FeeSettings            []GenesisFeeSetting   `json:"fee_settings"`
-	AuthorisedAddresses    []sdkTypes.AccAddress `json:"authorised_addresses"`
-	Multiplier             string                `json:"multiplier"`
-	AssignedMsgFeeSettings []AssignMsgFeeSetting `json:"assigned_fee"`
+	FeeSettings              []GenesisFeeSetting     `json:"fee_settings"`
+	AuthorisedAddresses      []sdkTypes.AccAddress   `json:"authorised_addresses"`
+	Multiplier               string                  `json:"multiplier"`
+	TokenMultiplier          string                  `json:"token_multiplier"`
+	AssignedMsgFeeSettings   []AssignMsgFeeSetting   `json:"assigned_fee"`
+	AssignedAccFeeSettings   []AssignAccFeeSetting   `json:"assigned_acc_fee"`
+	AssignedTokenFeeSettings []AssignTokenFeeSetting `json:"assigned_token_fee"`
+	FeeCollectors            []GenesisFeeCollector   `json:"fee_collectors"`
+	FeeDistribution          *FeeDistribution        `json:"fee_distribution,omitempty"`
+	FeeTokens                []FeeToken              `json:"fee_tokens"`
+	FeeAllowances            []FeeAllowance          `json:"fee_allowances"`
+	ScheduledFeeChanges      []ScheduledFeeChange    `json:"scheduled_fee_changes"`
+	FeeChanges               []FeeChange             `json:"fee_changes"`
 }
 
 type AssignMsgFeeSetting struct {
@@ -17,11 +26,29 @@ type AssignMsgFeeSetting struct {
 	MsgType string `json:"msg_type"`
 }
 
+type AssignAccFeeSetting struct {
+	Name    string              `json:"name"`
+	Account sdkTypes.AccAddress `json:"account"`
+}
+
+type AssignTokenFeeSetting struct {
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+	Action string `json:"action"`
+}
+
+type GenesisFeeCollector struct {
+	Module    string                `json:"module"`
+	Addresses []sdkTypes.AccAddress `json:"addresses"`
+}
+
 type GenesisFeeSetting struct {
-	Name       string         `json:"name"`
-	Min        sdkTypes.Coins `json:"min"`
-	Max        sdkTypes.Coins `json:"max"`
-	Percentage string         `json:"percentage"`
+	Name       string              `json:"name"`
+	Min        sdkTypes.Coins      `json:"min"`
+	Max        sdkTypes.Coins      `json:"max"`
+	Percentage string              `json:"percentage"`
+	Issuer     sdkTypes.AccAddress `json:"issuer,omitempty"`
+	Brackets   []FeeBracket        `json:"brackets,omitempty"`
 }
 
 func DefaultGenesisState() GenesisState {
@@ -52,7 +79,12 @@ func DefaultGenesisState() GenesisState {
 func InitGenesis(ctx sdkTypes.Context, keeper *Keeper, genesisState GenesisState) {
 
 	for _, feeSetting := range genesisState.FeeSettings {
-		sysFee := NewMsgSysFeeSetting(feeSetting.Name, feeSetting.Min, feeSetting.Max, feeSetting.Percentage, genesisState.AuthorisedAddresses[0])
+		issuer := feeSetting.Issuer
+		if issuer.Empty() {
+			issuer = genesisState.AuthorisedAddresses[0]
+		}
+		sysFee := NewMsgSysFeeSetting(feeSetting.Name, feeSetting.Min, feeSetting.Max, feeSetting.Percentage, issuer)
+		sysFee.Brackets = feeSetting.Brackets
 		keeper.storeFeeSetting(ctx, sysFee)
 	}
 
@@ -61,11 +93,93 @@ func InitGenesis(ctx sdkTypes.Context, keeper *Keeper, genesisState GenesisState
 		keeper.assignFeeToMsg(ctx, msgAssignFeeToMsg)
 	}
 
+	for _, assignAccFeeSetting := range genesisState.AssignedAccFeeSettings {
+		msgAssignFeeToAcc := NewMsgAssignFeeToAcc(assignAccFeeSetting.Name, assignAccFeeSetting.Account, genesisState.AuthorisedAddresses[0])
+		keeper.assignFeeToAcc(ctx, msgAssignFeeToAcc)
+	}
+
+	for _, assignTokenFeeSetting := range genesisState.AssignedTokenFeeSettings {
+		err := keeper.assignFeeToTokenAction(ctx, assignTokenFeeSetting.Name, assignTokenFeeSetting.Symbol, assignTokenFeeSetting.Action)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, feeCollector := range genesisState.FeeCollectors {
+		keeper.SetFeeCollectorAddresses(ctx, feeCollector.Module, feeCollector.Addresses)
+	}
+
+	if genesisState.FeeDistribution != nil {
+		err := keeper.SetFeeDistribution(ctx, *genesisState.FeeDistribution)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, feeToken := range genesisState.FeeTokens {
+		err := keeper.storeFeeToken(ctx, feeToken)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, feeAllowance := range genesisState.FeeAllowances {
+		err := keeper.storeFeeAllowance(ctx, feeAllowance)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, scheduledFeeChange := range genesisState.ScheduledFeeChanges {
+		err := keeper.storeScheduledFeeChange(ctx, scheduledFeeChange)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// the history is restored as is, the genesis settings above are not recorded in it
+	for _, feeChange := range genesisState.FeeChanges {
+		keeper.appendFeeChange(ctx, feeChange)
+	}
+
 	keeper.storeFeeMultiplier(ctx, genesisState.Multiplier)
+	if genesisState.TokenMultiplier != "" {
+		keeper.storeTokenFeeMultiplier(ctx, genesisState.TokenMultiplier)
+	}
 	keeper.SetAuthorisedAddresses(ctx, genesisState.AuthorisedAddresses)
 
 }
 
-func ExportGenesis(keeper *Keeper) GenesisState {
-	return GenesisState{}
+func ExportGenesis(ctx sdkTypes.Context, keeper *Keeper) GenesisState {
+	var feeSettings []GenesisFeeSetting
+	for _, feeSetting := range keeper.ListAllSysFeeSetting(ctx) {
+		feeSettings = append(feeSettings, GenesisFeeSetting{
+			Name:       feeSetting.Name,
+			Min:        feeSetting.Min,
+			Max:        feeSetting.Max,
+			Percentage: feeSetting.Percentage,
+			Issuer:     feeSetting.Issuer,
+			Brackets:   feeSetting.Brackets,
+		})
+	}
+
+	multiplier, _ := keeper.GetFeeMultiplier(ctx)
+	tokenMultiplier, _ := keeper.GetTokenFeeMultiplier(ctx)
+	feeDistribution, _ := keeper.GetFeeDistribution(ctx)
+
+	return GenesisState{
+		FeeSettings:              feeSettings,
+		AuthorisedAddresses:      keeper.GetAuthorisedAddresses(ctx),
+		Multiplier:               multiplier,
+		TokenMultiplier:          tokenMultiplier,
+		AssignedMsgFeeSettings:   keeper.ListAllMsgFeeSettings(ctx),
+		AssignedAccFeeSettings:   keeper.ListAllAccFeeSettings(ctx),
+		AssignedTokenFeeSettings: keeper.ListAllTokenFeeSettings(ctx),
+		FeeCollectors:            keeper.ListAllFeeCollectors(ctx),
+		FeeDistribution:          feeDistribution,
+		FeeTokens:                keeper.ListAllFeeTokens(ctx),
+		FeeAllowances:            keeper.ListAllFeeAllowances(ctx),
+		ScheduledFeeChanges:      keeper.ListScheduledFeeChanges(ctx),
+		FeeChanges:               keeper.ListAllFeeChanges(ctx),
+	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maxonrow/maxonrow-go/types"
 )
 
 func NewHandler(keeper *Keeper) sdkTypes.Handler {
@@ -26,6 +27,16 @@ func NewHandler(keeper *Keeper) sdkTypes.Handler {
 			return handleMsgDeleteSysFeeSetting(ctx, keeper, msg)
 		case MsgDeleteAccFeeSetting:
 			return handleMsgDeleteAccFeeSetting(ctx, keeper, msg)
+		case MsgSetFeeToken:
+			return handleMsgSetFeeToken(ctx, keeper, msg)
+		case MsgDeleteFeeToken:
+			return handleMsgDeleteFeeToken(ctx, keeper, msg)
+		case MsgGrantFeeAllowance:
+			return handleMsgGrantFeeAllowance(ctx, keeper, msg)
+		case MsgRevokeFeeAllowance:
+			return handleMsgRevokeFeeAllowance(ctx, keeper, msg)
+		case MsgUseFeeAllowance:
+			return handleMsgUseFeeAllowance(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized fee Msg type: %v", msg.Type())
 			return sdkTypes.ErrUnknownRequest(errMsg).Result()
@@ -64,3 +75,29 @@ func handleMsgDeleteSysFeeSetting(ctx sdkTypes.Context, keeper *Keeper, msg MsgD
 func handleMsgDeleteAccFeeSetting(ctx sdkTypes.Context, keeper *Keeper, msg MsgDeleteAccFeeSetting) sdkTypes.Result {
 	return keeper.DeleteAccFeeSetting(ctx, msg)
 }
+
+func handleMsgSetFeeToken(ctx sdkTypes.Context, keeper *Keeper, msg MsgSetFeeToken) sdkTypes.Result {
+	return keeper.SetFeeToken(ctx, msg)
+}
+
+func handleMsgDeleteFeeToken(ctx sdkTypes.Context, keeper *Keeper, msg MsgDeleteFeeToken) sdkTypes.Result {
+	return keeper.DeleteFeeToken(ctx, msg)
+}
+
+func handleMsgGrantFeeAllowance(ctx sdkTypes.Context, keeper *Keeper, msg MsgGrantFeeAllowance) sdkTypes.Result {
+	return keeper.GrantFeeAllowance(ctx, msg)
+}
+
+func handleMsgRevokeFeeAllowance(ctx sdkTypes.Context, keeper *Keeper, msg MsgRevokeFeeAllowance) sdkTypes.Result {
+	return keeper.RevokeFeeAllowance(ctx, msg)
+}
+
+// the fee is already paid by the granter in the ante handler
+func handleMsgUseFeeAllowance(ctx sdkTypes.Context, keeper *Keeper, msg MsgUseFeeAllowance) sdkTypes.Result {
+	eventParam := []string{msg.Granter.String(), msg.Grantee.String()}
+	eventSignature := "UsedFeeAllowance(string,string)"
+
+	return sdkTypes.Result{
+		Events: types.MakeMxwEvents(eventSignature, msg.Grantee.String(), eventParam),
+	}
+}
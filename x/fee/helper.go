@@ -1,48 +1,79 @@
 package fee
 
 import (
-	"fmt"
-
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maxonrow/maxonrow-go/types"
 )
 
-func DefaultCalculateFee(ctx sdkTypes.Context, feeSetting *FeeSetting, amt sdkTypes.Coins) (sdkTypes.Coins, sdkTypes.Error) {
+// CalculateFee applies the percentage or the bracket of the fee setting to the amount, clamps it
+// to the min and max of the fee setting and applies the multiplier.
+// Tiered fee settings without max are not capped.
+func CalculateFee(ctx sdkTypes.Context, feeSetting *FeeSetting, mul string, amt sdkTypes.Coins) (sdkTypes.Coins, sdkTypes.Error) {
+
+	if feeSetting == nil {
+		panic("Fee setting should not be empty.")
+	}
 
 	amount := amt.AmountOf(types.CIN)
+	if amount.IsZero() && !feeSetting.IsTiered() {
+		return feeSetting.Min, nil
+	}
 	minFee := feeSetting.Min.AmountOf(types.CIN)
 	maxFee := feeSetting.Max.AmountOf(types.CIN)
-	percentageStr := feeSetting.Percentage
-
-	bigIntPercentage, ok := sdkTypes.NewIntFromString(percentageStr)
-	if !ok {
-		return nil, sdkTypes.ErrInternal(fmt.Sprintf("Invalid percentage: %s", percentageStr))
-	}
-
-	// percentage in the genesis file always power of -4
-	multiplier, _ := sdkTypes.NewIntFromString("10000")
-	fee := amount.Mul(bigIntPercentage)
-	fee = fee.Quo(multiplier)
+	multiplier := sdkTypes.MustNewDecFromStr(mul)
 
+	fee := calculateRawFee(feeSetting, amt).AmountOf(types.CIN)
 	if fee.LT(minFee) {
 		fee = minFee
 	}
-	if fee.GT(maxFee) {
+	if fee.GT(maxFee) && !(feeSetting.IsTiered() && maxFee.IsZero()) {
 		fee = maxFee
 	}
 
-	if fee.IsZero() {
-		zero, _ := sdkTypes.NewIntFromString("0")
-		var fees sdkTypes.Coins
-		fees = sdkTypes.Coins{
-			{
-				Denom:  types.CIN,
-				Amount: zero,
-			},
+	feeD := fee.ToDec().Mul(multiplier)
+	fee = feeD.RoundInt()
+
+	return sdkTypes.Coins{sdkTypes.NewCoin(types.CIN, fee)}, nil
+}
+
+// calculateRawFee returns the percentage or the bracket fee of the amount, before the min/max clamp and the multiplier.
+func calculateRawFee(feeSetting *FeeSetting, amt sdkTypes.Coins) sdkTypes.Coins {
+
+	amount := amt.AmountOf(types.CIN)
+	if feeSetting.IsTiered() {
+		return sdkTypes.Coins{sdkTypes.NewCoin(types.CIN, calculateBracketFee(feeSetting.Brackets, amount))}
+	}
+
+	percentage := sdkTypes.MustNewDecFromStr(feeSetting.Percentage)
+
+	feeD := amount.ToDec().Mul(percentage)
+	feeD = feeD.Quo(sdkTypes.MustNewDecFromStr("100.0"))
+
+	return sdkTypes.Coins{sdkTypes.NewCoin(types.CIN, feeD.RoundInt())}
+}
+
+// CalculateMultiSendFee charges every output of a multi send as a transfer of its own amount,
+// so each output pays at least the min of the fee setting. The fee is the sum of the outputs fees.
+func CalculateMultiSendFee(ctx sdkTypes.Context, feeSetting *FeeSetting, mul string, outputs []sdkTypes.Coins) (sdkTypes.Coins, sdkTypes.Error) {
+
+	var fee sdkTypes.Coins
+	for _, output := range outputs {
+		outputFee, err := CalculateFee(ctx, feeSetting, mul, output)
+		if err != nil {
+			return nil, err
 		}
+		fee = fee.Add(outputFee)
+	}
+
+	return fee, nil
+}
+
+func calculateRawMultiSendFee(feeSetting *FeeSetting, outputs []sdkTypes.Coins) sdkTypes.Coins {
 
-		return fees, nil
+	var fee sdkTypes.Coins
+	for _, output := range outputs {
+		fee = fee.Add(calculateRawFee(feeSetting, output))
 	}
 
-	return sdkTypes.Coins{sdkTypes.NewCoin(types.CIN, fee)}, nil
+	return fee
 }
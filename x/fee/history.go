@@ -0,0 +1,189 @@
+package fee
+
+import (
+	"encoding/binary"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maxonrow/maxonrow-go/types"
+)
+
+var prefixFeeChange = []byte("0x0D")
+var prefixFeeChangeSequence = []byte("0x0E")
+
+// Kinds of recorded fee changes.
+const (
+	HistoryFeeSetting      = "feeSetting"
+	HistoryMsgFee          = "msgFee"
+	HistoryAccFee          = "accFee"
+	HistoryTokenFee        = "tokenFee"
+	HistoryMultiplier      = "multiplier"
+	HistoryTokenMultiplier = "tokenMultiplier"
+)
+
+// the history is keyed by height and a sequence, so the changes are never overwritten and iterate in order.
+func getFeeChangeKey(height int64, sequence uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, sequence)
+
+	return append(getFeeChangeHeightKey(height), bz...)
+}
+
+func getFeeChangeHeightKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+
+	return append(append([]byte{}, prefixFeeChange...), bz...)
+}
+
+// FeeChange is a record in the append-only history of fee settings, assignments and multipliers.
+// Subject is the fee setting name, msg type, account or token symbol and action, empty for multipliers.
+// Fee setting changes keep the old and new fee setting, the others the old and new fee name or multiplier.
+// Empty new value means the record was deleted.
+type FeeChange struct {
+	Height        int64               `json:"height"`
+	Kind          string              `json:"kind"`
+	Subject       string              `json:"subject,omitempty"`
+	Issuer        sdkTypes.AccAddress `json:"issuer"`
+	OldValue      string              `json:"old_value,omitempty"`
+	NewValue      string              `json:"new_value,omitempty"`
+	OldFeeSetting *FeeSetting         `json:"old_fee_setting,omitempty"`
+	NewFeeSetting *FeeSetting         `json:"new_fee_setting,omitempty"`
+}
+
+func tokenFeeSubject(symbol, action string) string {
+	return symbol + "/" + action
+}
+
+func (k *Keeper) recordFeeChange(ctx sdkTypes.Context, kind, subject, oldValue, newValue string, issuer sdkTypes.AccAddress) {
+	k.appendFeeChange(ctx, FeeChange{
+		Height:   ctx.BlockHeight(),
+		Kind:     kind,
+		Subject:  subject,
+		Issuer:   issuer,
+		OldValue: oldValue,
+		NewValue: newValue,
+	})
+}
+
+func (k *Keeper) recordFeeSettingChange(ctx sdkTypes.Context, name string, oldFeeSetting, newFeeSetting *FeeSetting, issuer sdkTypes.AccAddress) {
+	k.appendFeeChange(ctx, FeeChange{
+		Height:        ctx.BlockHeight(),
+		Kind:          HistoryFeeSetting,
+		Subject:       name,
+		Issuer:        issuer,
+		OldFeeSetting: oldFeeSetting,
+		NewFeeSetting: newFeeSetting,
+	})
+}
+
+func (k *Keeper) appendFeeChange(ctx sdkTypes.Context, change FeeChange) {
+	store := ctx.KVStore(k.key)
+
+	var sequence uint64
+	bz := store.Get(prefixFeeChangeSequence)
+	if bz != nil {
+		sequence = binary.BigEndian.Uint64(bz)
+	}
+
+	store.Set(getFeeChangeKey(change.Height, sequence), k.cdc.MustMarshalBinaryLengthPrefixed(change))
+
+	next := make([]byte, 8)
+	binary.BigEndian.PutUint64(next, sequence+1)
+	store.Set(prefixFeeChangeSequence, next)
+}
+
+// updateFeeSetting stores the fee setting and records the change in the fee history.
+func (k *Keeper) updateFeeSetting(ctx sdkTypes.Context, msgFeeSetting MsgSysFeeSetting) sdkTypes.Result {
+	oldFeeSetting, _ := k.GetFeeSettingByName(ctx, msgFeeSetting.Name)
+
+	result := k.storeFeeSetting(ctx, msgFeeSetting)
+
+	newFeeSetting, _ := k.GetFeeSettingByName(ctx, msgFeeSetting.Name)
+	k.recordFeeSettingChange(ctx, msgFeeSetting.Name, oldFeeSetting, newFeeSetting, msgFeeSetting.Issuer)
+
+	return result
+}
+
+func (k *Keeper) updateFeeMultiplier(ctx sdkTypes.Context, multiplier string, issuer sdkTypes.AccAddress) {
+	oldMultiplier, _ := k.GetFeeMultiplier(ctx)
+	k.storeFeeMultiplier(ctx, multiplier)
+	k.recordFeeChange(ctx, HistoryMultiplier, "", oldMultiplier, multiplier, issuer)
+}
+
+func (k *Keeper) updateTokenFeeMultiplier(ctx sdkTypes.Context, multiplier string, issuer sdkTypes.AccAddress) {
+	oldMultiplier, _ := k.GetTokenFeeMultiplier(ctx)
+	k.storeTokenFeeMultiplier(ctx, multiplier)
+	k.recordFeeChange(ctx, HistoryTokenMultiplier, "", oldMultiplier, multiplier, issuer)
+}
+
+// getAssignedFeeName returns the fee name assigned under the key, empty if none.
+func (k *Keeper) getAssignedFeeName(ctx sdkTypes.Context, key []byte) string {
+	store := ctx.KVStore(k.key)
+	return string(store.Get(key))
+}
+
+// ListFeeChanges returns the fee changes made from the height to the height, inclusive, in order.
+func (k *Keeper) ListFeeChanges(ctx sdkTypes.Context, fromHeight, toHeight int64) []FeeChange {
+	store := ctx.KVStore(k.key)
+	iter := store.Iterator(getFeeChangeHeightKey(fromHeight), getFeeChangeHeightKey(toHeight+1))
+	defer iter.Close()
+
+	var changes []FeeChange
+	for ; iter.Valid(); iter.Next() {
+		var change FeeChange
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &change)
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+func (k *Keeper) ListAllFeeChanges(ctx sdkTypes.Context) []FeeChange {
+	store := ctx.KVStore(k.key)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixFeeChange)
+	defer iter.Close()
+
+	var changes []FeeChange
+	for ; iter.Valid(); iter.Next() {
+		var change FeeChange
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &change)
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// GetFeeSettingAtHeight returns the fee setting as it was at the height.
+// Fee settings from genesis are not in the history, when the setting was not changed up to
+// the height, it is the old value of the first change after it, or the current one.
+func (k *Keeper) GetFeeSettingAtHeight(ctx sdkTypes.Context, name string, height int64) (*FeeSetting, sdkTypes.Error) {
+	var feeSetting *FeeSetting
+	changed := false
+
+	for _, change := range k.ListAllFeeChanges(ctx) {
+		if change.Kind != HistoryFeeSetting || change.Subject != name {
+			continue
+		}
+
+		if change.Height > height {
+			if !changed {
+				feeSetting = change.OldFeeSetting
+				changed = true
+			}
+			break
+		}
+
+		feeSetting = change.NewFeeSetting
+		changed = true
+	}
+
+	if !changed {
+		return k.GetFeeSettingByName(ctx, name)
+	}
+
+	if feeSetting == nil {
+		return nil, types.ErrFeeSettingNotExists(name)
+	}
+
+	return feeSetting, nil
+}
@@ -2,6 +2,7 @@ package fee
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,13 @@ import (
 type Keeper struct {
 	key sdkTypes.StoreKey
 	cdc *codec.Codec
+
+	nonFungibleTokenKeeper TokenKeeper
+}
+
+// TokenKeeper tells if a token exists, the token keepers use the fee keeper so they are set after it is created.
+type TokenKeeper interface {
+	TokenExists(ctx sdkTypes.Context, symbol string) bool
 }
 
 type FeeSetting struct {
@@ -19,6 +27,7 @@ type FeeSetting struct {
 	Max        sdkTypes.Coins      `json:"max"`
 	Percentage string              `json:"percentage"`
 	Issuer     sdkTypes.AccAddress `json:"issuer"`
+	Brackets   []FeeBracket        `json:"brackets,omitempty"`
 }
 
 const (
@@ -27,6 +36,16 @@ const (
 	BurnFungibleToken      = "burn"
 	TransferTokenOwnership = "transferOwnership"
 	AcceptTokenOwnership   = "acceptOwnership"
+
+	// Non fungible token only actions
+	TransferNonFungibleItem           = "transferItem"
+	MintNonFungibleItem               = "mintItem"
+	BurnNonFungibleItem               = "burnItem"
+	TransferNonFungibleTokenOwnership = "transferNonFungibleOwnership"
+	AcceptNonFungibleTokenOwnership   = "acceptNonFungibleOwnership"
+	EndorseNonFungibleItem            = "endorse"
+	UpdateNonFungibleItem             = "updateItemMetadata"
+	UpdateNonFungibleMetadata         = "updateMetadata"
 )
 
 var prefixAuthorised = []byte("0x01")
@@ -39,7 +58,11 @@ var prefixMultiplier = []byte("0x50")
 var prefixTokenMultiplier = []byte("0x51")
 
 // Token Actions
-var tokenActions = []string{TransferFungibleToken, MintFungibleToken, BurnFungibleToken, TransferTokenOwnership, AcceptTokenOwnership}
+var tokenActions = []string{TransferFungibleToken, MintFungibleToken, BurnFungibleToken, TransferTokenOwnership, AcceptTokenOwnership,
+	TransferNonFungibleItem, MintNonFungibleItem, BurnNonFungibleItem, TransferNonFungibleTokenOwnership, AcceptNonFungibleTokenOwnership,
+	EndorseNonFungibleItem, UpdateNonFungibleItem, UpdateNonFungibleMetadata}
+var nonFungibleTokenActions = []string{TransferNonFungibleItem, MintNonFungibleItem, BurnNonFungibleItem, TransferNonFungibleTokenOwnership,
+	AcceptNonFungibleTokenOwnership, EndorseNonFungibleItem, UpdateNonFungibleItem, UpdateNonFungibleMetadata}
 
 // keys
 func getAuthorisedKey() []byte {
@@ -82,6 +105,11 @@ func NewKeeper(cdc *codec.Codec, key sdkTypes.StoreKey) Keeper {
 	}
 }
 
+// SetNonFungibleTokenKeeper sets the keeper the nonfungible token actions are checked with.
+func (k *Keeper) SetNonFungibleTokenKeeper(nonFungibleTokenKeeper TokenKeeper) {
+	k.nonFungibleTokenKeeper = nonFungibleTokenKeeper
+}
+
 func (k *Keeper) SetAuthorisedAddresses(ctx sdkTypes.Context, addresses []sdkTypes.AccAddress) {
 	feeStore := ctx.KVStore(k.key)
 	key := getAuthorisedKey()
@@ -210,7 +238,23 @@ func (k *Keeper) CreateFeeSetting(
 		return sdkTypes.ErrUnknownRequest("Not authorised to create fee setting.").Result()
 	}
 
-	return k.storeFeeSetting(ctx, feeSettingMsg)
+	if isScheduled(ctx, feeSettingMsg.EffectiveHeight) {
+		return k.scheduleFeeChange(ctx, ScheduledFeeChange{
+			EffectiveHeight: feeSettingMsg.EffectiveHeight,
+			Kind:            ScheduledFeeSetting,
+			FeeSetting: &FeeSetting{
+				Name:       feeSettingMsg.Name,
+				Min:        feeSettingMsg.Min,
+				Max:        feeSettingMsg.Max,
+				Percentage: feeSettingMsg.Percentage,
+				Issuer:     feeSettingMsg.Issuer,
+				Brackets:   feeSettingMsg.Brackets,
+			},
+			Issuer: feeSettingMsg.Issuer,
+		})
+	}
+
+	return k.updateFeeSetting(ctx, feeSettingMsg)
 }
 
 func (k *Keeper) GetFeeSettingByName(ctx sdkTypes.Context, name string) (*FeeSetting, sdkTypes.Error) {
@@ -236,6 +280,7 @@ func (k *Keeper) storeFeeSetting(ctx sdkTypes.Context, msgFeeSetting MsgSysFeeSe
 	feeSetting.Max = msgFeeSetting.Max
 	feeSetting.Issuer = msgFeeSetting.Issuer
 	feeSetting.Percentage = msgFeeSetting.Percentage
+	feeSetting.Brackets = msgFeeSetting.Brackets
 
 	store := ctx.KVStore(k.key)
 	keyFeeSettingType := getSysFeeSettingKey(feeSetting.Name)
@@ -264,7 +309,9 @@ func (k *Keeper) AssignFeeToMsg(
 		return types.ErrFeeSettingNotExists(msgAssignFeeToMsg.FeeName).Result()
 	}
 
+	oldFeeName := k.getAssignedFeeName(ctx, getMsgFeeSettingKey(msgAssignFeeToMsg.MsgType))
 	k.assignFeeToMsg(ctx, msgAssignFeeToMsg)
+	k.recordFeeChange(ctx, HistoryMsgFee, msgAssignFeeToMsg.MsgType, oldFeeName, msgAssignFeeToMsg.FeeName, msgAssignFeeToMsg.Issuer)
 
 	eventParam := []string{msgAssignFeeToMsg.GetSigners()[0].String(), msgAssignFeeToMsg.MsgType}
 	eventSignature := "CreatedTxFeeSetting(string,string)"
@@ -287,7 +334,9 @@ func (k *Keeper) AssignFeeToAcc(
 		return types.ErrFeeSettingNotExists(msgAssignFeeToAcc.FeeName).Result()
 	}
 
+	oldFeeName := k.getAssignedFeeName(ctx, getAccFeeSettingKey(msgAssignFeeToAcc.Account))
 	k.assignFeeToAcc(ctx, msgAssignFeeToAcc)
+	k.recordFeeChange(ctx, HistoryAccFee, msgAssignFeeToAcc.Account.String(), oldFeeName, msgAssignFeeToAcc.FeeName, msgAssignFeeToAcc.Issuer)
 
 	eventParam := []string{msgAssignFeeToAcc.GetSigners()[0].String(), msgAssignFeeToAcc.Account.String()}
 	eventSignature := "CreatedAccountFeeSetting(string,string)"
@@ -306,7 +355,7 @@ func (k *Keeper) AssignFeeToToken(
 		return sdkTypes.ErrUnknownRequest("Not authorised to create msg fee setting.").Result()
 	}
 
-	err := k.AssignFeeToTokenAction(ctx, msgAssignFeeToToken.FeeName, msgAssignFeeToToken.Symbol, msgAssignFeeToToken.Action)
+	err := k.AssignFeeToTokenAction(ctx, msgAssignFeeToToken.FeeName, msgAssignFeeToToken.Symbol, msgAssignFeeToToken.Action, msgAssignFeeToToken.Issuer)
 	if err != nil {
 		return err.Result()
 	}
@@ -328,7 +377,16 @@ func (k *Keeper) CreateMultiplier(
 		return sdkTypes.ErrUnknownRequest("Not authorised to create msg fee setting.").Result()
 	}
 
-	k.storeFeeMultiplier(ctx, msgMultiplier.Multiplier)
+	if isScheduled(ctx, msgMultiplier.EffectiveHeight) {
+		return k.scheduleFeeChange(ctx, ScheduledFeeChange{
+			EffectiveHeight: msgMultiplier.EffectiveHeight,
+			Kind:            ScheduledMultiplier,
+			Multiplier:      msgMultiplier.Multiplier,
+			Issuer:          msgMultiplier.Issuer,
+		})
+	}
+
+	k.updateFeeMultiplier(ctx, msgMultiplier.Multiplier, msgMultiplier.Issuer)
 
 	eventParam := []string{msgMultiplier.GetSigners()[0].String()}
 	eventSignature := "CreatedFeeMultiplier(string)"
@@ -347,7 +405,16 @@ func (k *Keeper) CreateTokenMultiplier(
 		return sdkTypes.ErrUnknownRequest("Not authorised to create msg fee setting.").Result()
 	}
 
-	k.storeTokenFeeMultiplier(ctx, msgTokenMultiplier.Multiplier)
+	if isScheduled(ctx, msgTokenMultiplier.EffectiveHeight) {
+		return k.scheduleFeeChange(ctx, ScheduledFeeChange{
+			EffectiveHeight: msgTokenMultiplier.EffectiveHeight,
+			Kind:            ScheduledTokenMultiplier,
+			Multiplier:      msgTokenMultiplier.Multiplier,
+			Issuer:          msgTokenMultiplier.Issuer,
+		})
+	}
+
+	k.updateTokenFeeMultiplier(ctx, msgTokenMultiplier.Multiplier, msgTokenMultiplier.Issuer)
 
 	eventParam := []string{msgTokenMultiplier.GetSigners()[0].String()}
 	eventSignature := "CreatedTokenFeeMultiplier(string)"
@@ -371,7 +438,25 @@ func (k *Keeper) assignFeeToAcc(ctx sdkTypes.Context, msg MsgAssignFeeToAcc) {
 	store.Set(key, []byte(msg.FeeName))
 }
 
-func (k *Keeper) AssignFeeToTokenAction(ctx sdkTypes.Context, feeName, symbol, action string) sdkTypes.Error {
+// AssignFeeToTokenAction assigns the fee setting to the token action and records the change in the fee history.
+func (k *Keeper) AssignFeeToTokenAction(ctx sdkTypes.Context, feeName, symbol, action string, issuer sdkTypes.AccAddress) sdkTypes.Error {
+	if IsNonFungibleAction(action) && !k.isNonFungibleToken(ctx, symbol) {
+		return types.ErrInvalidTokenAction()
+	}
+
+	oldFeeName := k.getAssignedFeeName(ctx, getTokenFeeSettingKey(symbol, action))
+
+	err := k.assignFeeToTokenAction(ctx, feeName, symbol, action)
+	if err != nil {
+		return err
+	}
+
+	k.recordFeeChange(ctx, HistoryTokenFee, tokenFeeSubject(symbol, action), oldFeeName, feeName, issuer)
+
+	return nil
+}
+
+func (k *Keeper) assignFeeToTokenAction(ctx sdkTypes.Context, feeName, symbol, action string) sdkTypes.Error {
 	if !k.FeeSettingExists(ctx, feeName) {
 		return types.ErrFeeSettingNotExists(feeName)
 	}
@@ -488,11 +573,17 @@ func (k *Keeper) DeleteFeeSetting(ctx sdkTypes.Context, msgDeleteSysFeeSetting M
 		return sdkTypes.ErrInternal("Fee setting is in used, delete failed.").Result()
 	}
 
+	oldFeeSetting, _ := k.GetFeeSettingByName(ctx, msgDeleteSysFeeSetting.Name)
+
 	store := ctx.KVStore(k.key)
 	key := getSysFeeSettingKey(msgDeleteSysFeeSetting.Name)
 
 	store.Delete(key)
 
+	if oldFeeSetting != nil {
+		k.recordFeeSettingChange(ctx, msgDeleteSysFeeSetting.Name, oldFeeSetting, nil, msgDeleteSysFeeSetting.Issuer)
+	}
+
 	eventParam := []string{msgDeleteSysFeeSetting.GetSigners()[0].String(), msgDeleteSysFeeSetting.Name}
 	eventSignature := "DeletedFeeSetting(string,string)"
 
@@ -514,8 +605,10 @@ func (k *Keeper) DeleteAccFeeSetting(ctx sdkTypes.Context, msgDeleteAccFeeSettin
 
 	store := ctx.KVStore(k.key)
 	key := getAccFeeSettingKey(msgDeleteAccFeeSetting.Account)
+	oldFeeName := k.getAssignedFeeName(ctx, key)
 
 	store.Delete(key)
+	k.recordFeeChange(ctx, HistoryAccFee, msgDeleteAccFeeSetting.Account.String(), oldFeeName, "", msgDeleteAccFeeSetting.Issuer)
 
 	eventParam := []string{msgDeleteAccFeeSetting.GetSigners()[0].String(), msgDeleteAccFeeSetting.Account.String()}
 	eventSignature := "DeletedAccountFeeSetting(string,string)"
@@ -528,9 +621,7 @@ func (k *Keeper) DeleteAccFeeSetting(ctx sdkTypes.Context, msgDeleteAccFeeSettin
 func (k *Keeper) ListAllSysFeeSetting(ctx sdkTypes.Context) []FeeSetting {
 
 	store := ctx.KVStore(k.key)
-	start := append(prefixSysFeeSetting, 0x00)
-	end := append(prefixSysFeeSetting, 0xFF)
-	iter := store.Iterator(start, end)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixSysFeeSetting)
 	defer iter.Close()
 
 	var lst = make([]FeeSetting, 0)
@@ -548,6 +639,96 @@ func (k *Keeper) ListAllSysFeeSetting(ctx sdkTypes.Context) []FeeSetting {
 	return lst
 }
 
+func (k *Keeper) ListAllMsgFeeSettings(ctx sdkTypes.Context) []AssignMsgFeeSetting {
+
+	store := ctx.KVStore(k.key)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixMsgFeeSetting)
+	defer iter.Close()
+
+	var lst = make([]AssignMsgFeeSetting, 0)
+
+	for {
+		if !iter.Valid() {
+			break
+		}
+		msgType := string(iter.Key()[len(prefixMsgFeeSetting):])
+		lst = append(lst, AssignMsgFeeSetting{Name: string(iter.Value()), MsgType: msgType})
+
+		iter.Next()
+	}
+	return lst
+}
+
+func (k *Keeper) ListAllAccFeeSettings(ctx sdkTypes.Context) []AssignAccFeeSetting {
+
+	store := ctx.KVStore(k.key)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixAccFeeSetting)
+	defer iter.Close()
+
+	var lst = make([]AssignAccFeeSetting, 0)
+
+	for {
+		if !iter.Valid() {
+			break
+		}
+		acc := sdkTypes.AccAddress(iter.Key()[len(prefixAccFeeSetting):])
+		lst = append(lst, AssignAccFeeSetting{Name: string(iter.Value()), Account: acc})
+
+		iter.Next()
+	}
+	return lst
+}
+
+func (k *Keeper) ListAllTokenFeeSettings(ctx sdkTypes.Context) []AssignTokenFeeSetting {
+
+	store := ctx.KVStore(k.key)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixTokenFeeSetting)
+	defer iter.Close()
+
+	var lst = make([]AssignTokenFeeSetting, 0)
+
+	for {
+		if !iter.Valid() {
+			break
+		}
+		tokenAction := string(iter.Key()[len(prefixTokenFeeSetting):])
+		sep := strings.LastIndex(tokenAction, ":")
+		if sep < 0 {
+			panic(fmt.Sprintf("Invalid token fee setting key: %s", tokenAction))
+		}
+		lst = append(lst, AssignTokenFeeSetting{
+			Name:   string(iter.Value()),
+			Symbol: tokenAction[:sep],
+			Action: tokenAction[sep+1:],
+		})
+
+		iter.Next()
+	}
+	return lst
+}
+
+func (k *Keeper) ListAllFeeCollectors(ctx sdkTypes.Context) []GenesisFeeCollector {
+
+	store := ctx.KVStore(k.key)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixFeeCollector)
+	defer iter.Close()
+
+	var lst = make([]GenesisFeeCollector, 0)
+
+	for {
+		if !iter.Valid() {
+			break
+		}
+		var ah types.AddressHolder
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &ah)
+		module := string(iter.Key()[len(prefixFeeCollector):])
+		lst = append(lst, GenesisFeeCollector{Module: module, Addresses: ah})
+
+		iter.Next()
+	}
+	return lst
+}
+
 func (k *Keeper) IsFeeSettingUsed(ctx sdkTypes.Context, feeName string) bool {
 
 	// default created upon genesis.
@@ -572,7 +753,9 @@ func (k *Keeper) IsFeeSettingUsed(ctx sdkTypes.Context, feeName string) bool {
 
 		iter.Next()
 	}
-	return false
+
+	// a pending scheduled change still needs the fee setting when it is applied.
+	return k.isFeeSettingScheduled(ctx, feeName)
 }
 
 func ContainAction(tokenAction string) bool {
@@ -583,3 +766,17 @@ func ContainAction(tokenAction string) bool {
 	}
 	return false
 }
+
+// IsNonFungibleAction tells if the action is one only the nonfungible tokens have.
+func IsNonFungibleAction(tokenAction string) bool {
+	for _, action := range nonFungibleTokenActions {
+		if tokenAction == action {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *Keeper) isNonFungibleToken(ctx sdkTypes.Context, symbol string) bool {
+	return k.nonFungibleTokenKeeper != nil && k.nonFungibleTokenKeeper.TokenExists(ctx, symbol)
+}
@@ -464,3 +464,257 @@ func TestTxFeeSetting(t *testing.T) {
 	}
 
 }
+
+type nonFungibleTokens map[string]bool
+
+func (tokens nonFungibleTokens) TokenExists(ctx sdkTypes.Context, symbol string) bool {
+	return tokens[symbol]
+}
+
+func TestAssignFeeToNonFungibleTokenAction(t *testing.T) {
+
+	fmt.Printf("============\nStart Test : %s \n", "TestAssignFeeToNonFungibleTokenAction")
+
+	ctx, keeper := PrepareTest(t)
+
+	issuer := sdkTypes.AccAddress([]byte("issuer_address______"))
+	amt := sdkTypes.Coins{
+		{
+			Denom:  "cin",
+			Amount: sdkTypes.NewInt(1000000),
+		},
+	}
+
+	keeper.SetAuthorisedAddresses(ctx, []sdkTypes.AccAddress{issuer})
+	keeper.CreateFeeSetting(ctx, fee.NewMsgSysFeeSetting("nft_fee", amt, amt, "0", issuer))
+	keeper.SetNonFungibleTokenKeeper(nonFungibleTokens{"TNFT": true})
+
+	for _, action := range []string{fee.MintNonFungibleItem, fee.EndorseNonFungibleItem, fee.UpdateNonFungibleItem, fee.UpdateNonFungibleMetadata} {
+		assert.True(t, fee.ContainAction(action))
+
+		err := keeper.AssignFeeToTokenAction(ctx, "nft_fee", "TNFT", action, issuer)
+		assert.Nil(t, err)
+
+		feeSetting, err := keeper.GetTokenFeeSetting(ctx, "TNFT", action)
+		if assert.Nil(t, err) {
+			assert.Equal(t, "nft_fee", feeSetting.Name)
+		}
+	}
+
+	assert.False(t, fee.IsNonFungibleAction(fee.MintFungibleToken))
+	assert.True(t, fee.IsNonFungibleAction(fee.MintNonFungibleItem))
+	assert.True(t, fee.IsNonFungibleAction(fee.EndorseNonFungibleItem))
+	assert.NotNil(t, keeper.AssignFeeToTokenAction(ctx, "nft_fee", "TNFT", "unknown", issuer))
+
+	// the nonfungible only actions can't be assigned to a fungible token
+	assert.Nil(t, keeper.AssignFeeToTokenAction(ctx, "nft_fee", "TFT", fee.MintFungibleToken, issuer))
+	assert.NotNil(t, keeper.AssignFeeToTokenAction(ctx, "nft_fee", "TFT", fee.EndorseNonFungibleItem, issuer))
+	assert.NotNil(t, keeper.AssignFeeToTokenAction(ctx, "nft_fee", "TFT", fee.MintNonFungibleItem, issuer))
+}
+
+func TestFeeToken(t *testing.T) {
+	ctx, keeper := PrepareTest(t)
+
+	collector, err := sdkTypes.AccAddressFromBech32("mxw1yw6mg7fty4mzcwupvzek53x5egm7tp2ldwaxq3")
+	assert.NoError(t, err)
+	issuer, err := sdkTypes.AccAddressFromBech32("mxw1yyz3h9calxmvjp4x05nnn70a8ex7fee3th7r7k")
+	assert.NoError(t, err)
+	keeper.SetAuthorisedAddresses(ctx, []sdkTypes.AccAddress{issuer})
+
+	assert.False(t, keeper.IsFeeToken(ctx, "TT"))
+	assert.False(t, keeper.SetFeeToken(ctx, fee.NewMsgSetFeeToken("TT", "0", collector, issuer)).IsOK())
+	assert.False(t, keeper.SetFeeToken(ctx, fee.NewMsgSetFeeToken("TT", "0.5", collector, collector)).IsOK())
+	assert.True(t, keeper.SetFeeToken(ctx, fee.NewMsgSetFeeToken("TT", "0.5", collector, issuer)).IsOK())
+	assert.True(t, keeper.IsFeeToken(ctx, "TT"))
+	assert.Len(t, keeper.ListAllFeeTokens(ctx), 1)
+
+	// converted fee is rounded up
+	fees := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1001)))
+	converted, convertErr := keeper.ConvertFee(ctx, fees, "TT")
+	assert.Nil(t, convertErr)
+	assert.Equal(t, sdkTypes.Coins{sdkTypes.Coin{Denom: "TT", Amount: sdkTypes.NewInt(501)}}, converted)
+
+	_, convertErr = keeper.ConvertFee(ctx, fees, "XX")
+	assert.NotNil(t, convertErr)
+
+	assert.True(t, keeper.DeleteFeeToken(ctx, fee.NewMsgDeleteFeeToken("TT", issuer)).IsOK())
+	assert.False(t, keeper.IsFeeToken(ctx, "TT"))
+}
+
+func TestScheduledFeeChanges(t *testing.T) {
+	ctx, keeper := PrepareTest(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	issuer, err := sdkTypes.AccAddressFromBech32("mxw1yyz3h9calxmvjp4x05nnn70a8ex7fee3th7r7k")
+	assert.NoError(t, err)
+	keeper.SetAuthorisedAddresses(ctx, []sdkTypes.AccAddress{issuer})
+
+	// without effective height the change takes effect right away
+	assert.True(t, keeper.CreateMultiplier(ctx, fee.NewMsgMultiplier("1", issuer)).IsOK())
+
+	msgMultiplier := fee.NewMsgMultiplier("2", issuer)
+	msgMultiplier.EffectiveHeight = 12
+	assert.True(t, keeper.CreateMultiplier(ctx, msgMultiplier).IsOK())
+
+	min := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1)))
+	max := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(100)))
+	msgFeeSetting := fee.NewMsgSysFeeSetting("scheduled", min, max, "1", issuer)
+	msgFeeSetting.EffectiveHeight = 11
+	assert.True(t, keeper.CreateFeeSetting(ctx, msgFeeSetting).IsOK())
+
+	changes := keeper.ListScheduledFeeChanges(ctx)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, fee.ScheduledFeeSetting, changes[0].Kind)
+	assert.Equal(t, fee.ScheduledMultiplier, changes[1].Kind)
+	assert.False(t, keeper.FeeSettingExists(ctx, "scheduled"))
+
+	events := keeper.ApplyScheduledFeeChanges(ctx.WithBlockHeight(11))
+	assert.Len(t, events, 1)
+	assert.True(t, keeper.FeeSettingExists(ctx, "scheduled"))
+	multiplier, _ := keeper.GetFeeMultiplier(ctx)
+	assert.Equal(t, "1", multiplier)
+
+	events = keeper.ApplyScheduledFeeChanges(ctx.WithBlockHeight(12))
+	assert.Len(t, events, 1)
+	multiplier, _ = keeper.GetFeeMultiplier(ctx)
+	assert.Equal(t, "2", multiplier)
+	assert.Empty(t, keeper.ListScheduledFeeChanges(ctx))
+}
+
+func TestDeleteScheduledFeeSetting(t *testing.T) {
+	ctx, keeper := PrepareTest(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	issuer, err := sdkTypes.AccAddressFromBech32("mxw1yyz3h9calxmvjp4x05nnn70a8ex7fee3th7r7k")
+	assert.NoError(t, err)
+	keeper.SetAuthorisedAddresses(ctx, []sdkTypes.AccAddress{issuer})
+
+	min := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1)))
+	max := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(100)))
+	assert.True(t, keeper.CreateFeeSetting(ctx, fee.NewMsgSysFeeSetting("scheduled", min, min, "1", issuer)).IsOK())
+
+	msgFeeSetting := fee.NewMsgSysFeeSetting("scheduled", min, max, "1", issuer)
+	msgFeeSetting.EffectiveHeight = 11
+	assert.True(t, keeper.CreateFeeSetting(ctx, msgFeeSetting).IsOK())
+
+	// the fee setting can't be deleted while a change to it is pending
+	assert.True(t, keeper.IsFeeSettingUsed(ctx, "scheduled"))
+	assert.False(t, keeper.DeleteFeeSetting(ctx, fee.NewMsgDeleteSysFeeSetting("scheduled", issuer)).IsOK())
+
+	keeper.ApplyScheduledFeeChanges(ctx.WithBlockHeight(11))
+	assert.False(t, keeper.IsFeeSettingUsed(ctx, "scheduled"))
+	assert.True(t, keeper.DeleteFeeSetting(ctx, fee.NewMsgDeleteSysFeeSetting("scheduled", issuer)).IsOK())
+}
+
+func TestTieredFeeSetting(t *testing.T) {
+	ctx, keeper := PrepareTest(t)
+
+	issuer, err := sdkTypes.AccAddressFromBech32("mxw1yyz3h9calxmvjp4x05nnn70a8ex7fee3th7r7k")
+	assert.NoError(t, err)
+	keeper.SetAuthorisedAddresses(ctx, []sdkTypes.AccAddress{issuer})
+
+	bracket1, err := fee.ParseFeeBracket("1000cin=0.1")
+	assert.NoError(t, err)
+	bracket2, err := fee.ParseFeeBracket("=5000cin")
+	assert.NoError(t, err)
+	_, err = fee.ParseFeeBracket("1000cin")
+	assert.Error(t, err)
+
+	msg := fee.NewMsgSysFeeSetting("tiered", sdkTypes.Coins{}, sdkTypes.Coins{}, "", issuer)
+
+	// the last bracket must be unbounded
+	msg.Brackets = []fee.FeeBracket{bracket1}
+	assert.NotNil(t, msg.ValidateBasic())
+
+	// thresholds must be increasing
+	msg.Brackets = []fee.FeeBracket{bracket1, bracket1, bracket2}
+	assert.NotNil(t, msg.ValidateBasic())
+
+	// a bracket charges either a percentage or a flat fee
+	msg.Brackets = []fee.FeeBracket{{Threshold: bracket1.Threshold, Percentage: "0.1", Flat: bracket2.Flat}, bracket2}
+	assert.NotNil(t, msg.ValidateBasic())
+
+	// thresholds and flat fees are in cin
+	tokenBracket, err := fee.ParseFeeBracket("1000mxw=0.1")
+	assert.NoError(t, err)
+	msg.Brackets = []fee.FeeBracket{tokenBracket, bracket2}
+	assert.NotNil(t, msg.ValidateBasic())
+	msg.Brackets = []fee.FeeBracket{bracket1, {Flat: sdkTypes.NewCoins(sdkTypes.NewCoin("mxw", sdkTypes.NewInt(5000)))}}
+	assert.NotNil(t, msg.ValidateBasic())
+	msg.Brackets = []fee.FeeBracket{bracket1, {Flat: sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(5000)), sdkTypes.NewCoin("mxw", sdkTypes.NewInt(1)))}}
+	assert.NotNil(t, msg.ValidateBasic())
+
+	msg.Brackets = []fee.FeeBracket{bracket1, bracket2}
+	assert.Nil(t, msg.ValidateBasic())
+	assert.True(t, keeper.CreateFeeSetting(ctx, msg).IsOK())
+
+	feeSetting, getErr := keeper.GetFeeSettingByName(ctx, "tiered")
+	assert.Nil(t, getErr)
+	assert.True(t, feeSetting.IsTiered())
+	assert.Equal(t, msg.Brackets, feeSetting.Brackets)
+}
+
+func TestFeeHistory(t *testing.T) {
+	ctx, keeper := PrepareTest(t)
+
+	issuer, err := sdkTypes.AccAddressFromBech32("mxw1yyz3h9calxmvjp4x05nnn70a8ex7fee3th7r7k")
+	assert.NoError(t, err)
+	keeper.SetAuthorisedAddresses(ctx, []sdkTypes.AccAddress{issuer})
+
+	amt := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000)))
+
+	ctx = ctx.WithBlockHeight(10)
+	assert.True(t, keeper.CreateFeeSetting(ctx, fee.NewMsgSysFeeSetting("test", amt, amt, "0.1", issuer)).IsOK())
+
+	ctx = ctx.WithBlockHeight(20)
+	assert.True(t, keeper.CreateFeeSetting(ctx, fee.NewMsgSysFeeSetting("test", amt, amt, "0.2", issuer)).IsOK())
+	assert.True(t, keeper.AssignFeeToMsg(ctx, fee.NewMsgAssignFeeToMsg("test", "bank-send", issuer)).IsOK())
+	assert.True(t, keeper.CreateMultiplier(ctx, fee.NewMsgMultiplier("2", issuer)).IsOK())
+
+	// the fee setting didn't exist before it was created
+	_, getErr := keeper.GetFeeSettingAtHeight(ctx, "test", 5)
+	assert.NotNil(t, getErr)
+
+	feeSetting, getErr := keeper.GetFeeSettingAtHeight(ctx, "test", 15)
+	assert.Nil(t, getErr)
+	assert.Equal(t, "0.1", feeSetting.Percentage)
+
+	feeSetting, getErr = keeper.GetFeeSettingAtHeight(ctx, "test", 25)
+	assert.Nil(t, getErr)
+	assert.Equal(t, "0.2", feeSetting.Percentage)
+
+	assert.Len(t, keeper.ListFeeChanges(ctx, 10, 10), 1)
+
+	changes := keeper.ListFeeChanges(ctx, 20, 30)
+	assert.Len(t, changes, 3)
+	assert.Equal(t, "0.1", changes[0].OldFeeSetting.Percentage)
+	assert.Equal(t, "0.2", changes[0].NewFeeSetting.Percentage)
+	assert.Equal(t, fee.HistoryMsgFee, changes[1].Kind)
+	assert.Equal(t, "bank-send", changes[1].Subject)
+	assert.Equal(t, "test", changes[1].NewValue)
+	assert.Equal(t, fee.HistoryMultiplier, changes[2].Kind)
+	assert.Equal(t, "2", changes[2].NewValue)
+	assert.Equal(t, issuer, changes[2].Issuer)
+}
+
+func TestListAllAccFeeSettings(t *testing.T) {
+	ctx, keeper := PrepareTest(t)
+
+	issuer, err := sdkTypes.AccAddressFromBech32("mxw1yyz3h9calxmvjp4x05nnn70a8ex7fee3th7r7k")
+	assert.NoError(t, err)
+	keeper.SetAuthorisedAddresses(ctx, []sdkTypes.AccAddress{issuer})
+
+	amt := sdkTypes.NewCoins(sdkTypes.NewCoin("cin", sdkTypes.NewInt(1000)))
+	assert.True(t, keeper.CreateFeeSetting(ctx, fee.NewMsgSysFeeSetting("test", amt, amt, "0.1", issuer)).IsOK())
+
+	// accounts starting with 0x00 and 0xFF are the first and the last of the prefix
+	first := sdkTypes.AccAddress(append([]byte{0x00}, issuer.Bytes()[1:]...))
+	last := sdkTypes.AccAddress(append([]byte{0xFF}, issuer.Bytes()[1:]...))
+	assert.True(t, keeper.AssignFeeToAcc(ctx, fee.NewMsgAssignFeeToAcc("test", first, issuer)).IsOK())
+	assert.True(t, keeper.AssignFeeToAcc(ctx, fee.NewMsgAssignFeeToAcc("test", last, issuer)).IsOK())
+
+	settings := keeper.ListAllAccFeeSettings(ctx)
+	assert.Len(t, settings, 2)
+	assert.Equal(t, first, settings[0].Account)
+	assert.Equal(t, last, settings[1].Account)
+}
@@ -1,6 +1,8 @@
 package fee
 
 import (
+	"time"
+
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,12 +13,15 @@ const routeName = "fee"
 // be derived from the message signer itself, but the CosmosSDK structure prevents this
 
 // MsgSysFeeSetting Create Fee Setting
+// Fee setting takes effect at the effective height, or right away when it is not set.
 type MsgSysFeeSetting struct {
-	Name       string              `json:"name"`
-	Min        sdkTypes.Coins      `json:"min"`
-	Max        sdkTypes.Coins      `json:"max"`
-	Percentage string              `json:"percentage"`
-	Issuer     sdkTypes.AccAddress `json:"issuer"`
+	Name            string              `json:"name"`
+	Min             sdkTypes.Coins      `json:"min"`
+	Max             sdkTypes.Coins      `json:"max"`
+	Percentage      string              `json:"percentage"`
+	Issuer          sdkTypes.AccAddress `json:"issuer"`
+	EffectiveHeight int64               `json:"effective_height,omitempty"`
+	Brackets        []FeeBracket        `json:"brackets,omitempty"`
 }
 
 func NewMsgSysFeeSetting(name string, min sdkTypes.Coins, max sdkTypes.Coins, percentage string, issuer sdkTypes.AccAddress) MsgSysFeeSetting {
@@ -46,6 +51,14 @@ func (msg MsgSysFeeSetting) ValidateBasic() sdkTypes.Error {
 		return sdkTypes.ErrInvalidCoins("Max fee cannot lower than minimum fee.")
 	}
 
+	if msg.EffectiveHeight < 0 {
+		return sdkTypes.ErrUnknownRequest("Effective height cant be negative.")
+	}
+
+	if err := validateFeeBrackets(msg.Brackets); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -229,8 +242,9 @@ func (msg MsgDeleteAccFeeSetting) GetSigners() []sdkTypes.AccAddress {
 
 // MsgMultiplier create/update fee multiplier
 type MsgMultiplier struct {
-	Multiplier string              `json:"multiplier"`
-	Issuer     sdkTypes.AccAddress `json:"issuer"`
+	Multiplier      string              `json:"multiplier"`
+	Issuer          sdkTypes.AccAddress `json:"issuer"`
+	EffectiveHeight int64               `json:"effective_height,omitempty"`
 }
 
 func NewMsgMultiplier(multiplier string, issuer sdkTypes.AccAddress) MsgMultiplier {
@@ -268,6 +282,10 @@ func (msg MsgMultiplier) ValidateBasic() sdkTypes.Error {
 		return sdkTypes.ErrInternal("Multiplier invalid.")
 	}
 
+	if msg.EffectiveHeight < 0 {
+		return sdkTypes.ErrUnknownRequest("Effective height cant be negative.")
+	}
+
 	return nil
 }
 
@@ -281,8 +299,9 @@ func (msg MsgMultiplier) GetSigners() []sdkTypes.AccAddress {
 
 // MsgTokenMultiplier create/update token fee multiplier
 type MsgTokenMultiplier struct {
-	Multiplier string              `json:"multiplier"`
-	Issuer     sdkTypes.AccAddress `json:"issuer"`
+	Multiplier      string              `json:"multiplier"`
+	Issuer          sdkTypes.AccAddress `json:"issuer"`
+	EffectiveHeight int64               `json:"effective_height,omitempty"`
 }
 
 func NewMsgTokenMultiplier(multiplier string, issuer sdkTypes.AccAddress) MsgTokenMultiplier {
@@ -320,6 +339,10 @@ func (msg MsgTokenMultiplier) ValidateBasic() sdkTypes.Error {
 		return sdkTypes.ErrInternal("Multiplier invalid.")
 	}
 
+	if msg.EffectiveHeight < 0 {
+		return sdkTypes.ErrUnknownRequest("Effective height cant be negative.")
+	}
+
 	return nil
 }
 
@@ -382,3 +405,214 @@ func (msg MsgAssignFeeToToken) GetSignBytes() []byte {
 func (msg MsgAssignFeeToToken) GetSigners() []sdkTypes.AccAddress {
 	return []sdkTypes.AccAddress{msg.Issuer}
 }
+
+type MsgSetFeeToken struct {
+	Symbol    string              `json:"symbol"`
+	Rate      string              `json:"rate"`
+	Collector sdkTypes.AccAddress `json:"collector"`
+	Issuer    sdkTypes.AccAddress `json:"issuer"`
+}
+
+func NewMsgSetFeeToken(symbol, rate string, collector, issuer sdkTypes.AccAddress) MsgSetFeeToken {
+	return MsgSetFeeToken{
+		Symbol:    symbol,
+		Rate:      rate,
+		Collector: collector,
+		Issuer:    issuer,
+	}
+}
+
+func (msg MsgSetFeeToken) Route() string {
+	return routeName
+}
+
+func (msg MsgSetFeeToken) Type() string {
+	return "setFeeToken"
+}
+
+func (msg MsgSetFeeToken) ValidateBasic() sdkTypes.Error {
+	if msg.Issuer.Empty() {
+		return sdkTypes.ErrInvalidAddress(msg.Issuer.String())
+	}
+
+	return NewFeeToken(msg.Symbol, msg.Rate, msg.Collector).ValidateBasic()
+}
+
+func (msg MsgSetFeeToken) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetFeeToken) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Issuer}
+}
+
+type MsgDeleteFeeToken struct {
+	Symbol string              `json:"symbol"`
+	Issuer sdkTypes.AccAddress `json:"issuer"`
+}
+
+func NewMsgDeleteFeeToken(symbol string, issuer sdkTypes.AccAddress) MsgDeleteFeeToken {
+	return MsgDeleteFeeToken{
+		Symbol: symbol,
+		Issuer: issuer,
+	}
+}
+
+func (msg MsgDeleteFeeToken) Route() string {
+	return routeName
+}
+
+func (msg MsgDeleteFeeToken) Type() string {
+	return "deleteFeeToken"
+}
+
+func (msg MsgDeleteFeeToken) ValidateBasic() sdkTypes.Error {
+	if msg.Issuer.Empty() {
+		return sdkTypes.ErrInvalidAddress(msg.Issuer.String())
+	}
+
+	if len(msg.Symbol) <= 0 {
+		return sdkTypes.ErrInvalidCoins("Symbol cant be empty.")
+	}
+
+	return nil
+}
+
+func (msg MsgDeleteFeeToken) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgDeleteFeeToken) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Issuer}
+}
+
+type MsgGrantFeeAllowance struct {
+	Granter    sdkTypes.AccAddress `json:"granter"`
+	Grantee    sdkTypes.AccAddress `json:"grantee"`
+	SpendLimit sdkTypes.Coins      `json:"spend_limit"`
+	PerTxLimit sdkTypes.Coins      `json:"per_tx_limit"`
+	Expiration time.Time           `json:"expiration"`
+	MsgTypes   []string            `json:"msg_types"`
+}
+
+func NewMsgGrantFeeAllowance(granter, grantee sdkTypes.AccAddress, spendLimit, perTxLimit sdkTypes.Coins, expiration time.Time, msgTypes []string) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{
+		Granter:    granter,
+		Grantee:    grantee,
+		SpendLimit: spendLimit,
+		PerTxLimit: perTxLimit,
+		Expiration: expiration,
+		MsgTypes:   msgTypes,
+	}
+}
+
+func (msg MsgGrantFeeAllowance) Route() string {
+	return routeName
+}
+
+func (msg MsgGrantFeeAllowance) Type() string {
+	return "grantFeeAllowance"
+}
+
+func (msg MsgGrantFeeAllowance) ValidateBasic() sdkTypes.Error {
+	allowance := FeeAllowance{
+		Granter:    msg.Granter,
+		Grantee:    msg.Grantee,
+		SpendLimit: msg.SpendLimit,
+		PerTxLimit: msg.PerTxLimit,
+		Expiration: msg.Expiration,
+		MsgTypes:   msg.MsgTypes,
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgGrantFeeAllowance) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Granter}
+}
+
+type MsgRevokeFeeAllowance struct {
+	Granter sdkTypes.AccAddress `json:"granter"`
+	Grantee sdkTypes.AccAddress `json:"grantee"`
+}
+
+func NewMsgRevokeFeeAllowance(granter, grantee sdkTypes.AccAddress) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+func (msg MsgRevokeFeeAllowance) Route() string {
+	return routeName
+}
+
+func (msg MsgRevokeFeeAllowance) Type() string {
+	return "revokeFeeAllowance"
+}
+
+func (msg MsgRevokeFeeAllowance) ValidateBasic() sdkTypes.Error {
+	if msg.Granter.Empty() {
+		return sdkTypes.ErrInvalidAddress(msg.Granter.String())
+	}
+
+	if msg.Grantee.Empty() {
+		return sdkTypes.ErrInvalidAddress(msg.Grantee.String())
+	}
+
+	return nil
+}
+
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Granter}
+}
+
+// MsgUseFeeAllowance names the granter paying the fee of the tx it is included in.
+// It is signed by the grantee and is not charged any fee itself.
+type MsgUseFeeAllowance struct {
+	Granter sdkTypes.AccAddress `json:"granter"`
+	Grantee sdkTypes.AccAddress `json:"grantee"`
+}
+
+func NewMsgUseFeeAllowance(granter, grantee sdkTypes.AccAddress) MsgUseFeeAllowance {
+	return MsgUseFeeAllowance{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+func (msg MsgUseFeeAllowance) Route() string {
+	return routeName
+}
+
+func (msg MsgUseFeeAllowance) Type() string {
+	return "useFeeAllowance"
+}
+
+func (msg MsgUseFeeAllowance) ValidateBasic() sdkTypes.Error {
+	if msg.Granter.Empty() {
+		return sdkTypes.ErrInvalidAddress(msg.Granter.String())
+	}
+
+	if msg.Grantee.Empty() {
+		return sdkTypes.ErrInvalidAddress(msg.Grantee.String())
+	}
+
+	return nil
+}
+
+func (msg MsgUseFeeAllowance) GetSignBytes() []byte {
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUseFeeAllowance) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Grantee}
+}
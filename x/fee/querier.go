@@ -21,6 +21,16 @@ const (
 	QueryIsFeeSettingExist  = "is_fee_setting_exist"
 	QueryIsFeeSettingInUsed = "is_fee_setting_in_used"
 	QueryIsTokenActionValid = "is_token_action_valid"
+	QueryFeeDistribution    = "get_fee_distribution"
+	QueryLastDistributed    = "get_last_distributed_fees"
+	QueryTotalDistributed   = "get_total_distributed_fees"
+	QueryFeeToken           = "get_fee_token"
+	QueryListFeeTokens      = "list_fee_tokens"
+	QueryFeeAllowance       = "get_fee_allowance"
+	QueryListFeeAllowances  = "list_fee_allowances"
+	QueryScheduledChanges   = "list_scheduled_fee_changes"
+	QueryFeeSettingAt       = "get_fee_setting_at_height"
+	QueryFeeChanges         = "list_fee_changes"
 )
 
 func NewQuerier(cdc *codec.Codec, keeper *Keeper) sdkTypes.Querier {
@@ -46,6 +56,26 @@ func NewQuerier(cdc *codec.Codec, keeper *Keeper) sdkTypes.Querier {
 			return queryIsFeeSettingInUsed(cdc, ctx, path[1:], req, keeper)
 		case QueryIsTokenActionValid:
 			return queryIsTokenActionValid(cdc, ctx, path[1:], req, keeper)
+		case QueryFeeDistribution:
+			return queryFeeDistribution(cdc, ctx, path[1:], req, keeper)
+		case QueryLastDistributed:
+			return queryLastDistributed(cdc, ctx, path[1:], req, keeper)
+		case QueryTotalDistributed:
+			return queryTotalDistributed(cdc, ctx, path[1:], req, keeper)
+		case QueryFeeToken:
+			return queryFeeToken(cdc, ctx, path[1:], req, keeper)
+		case QueryListFeeTokens:
+			return queryListFeeTokens(cdc, ctx, path[1:], req, keeper)
+		case QueryFeeAllowance:
+			return queryFeeAllowance(cdc, ctx, path[1:], req, keeper)
+		case QueryListFeeAllowances:
+			return queryListFeeAllowances(cdc, ctx, path[1:], req, keeper)
+		case QueryScheduledChanges:
+			return queryScheduledChanges(cdc, ctx, path[1:], req, keeper)
+		case QueryFeeSettingAt:
+			return queryFeeSettingAt(cdc, ctx, path[1:], req, keeper)
+		case QueryFeeChanges:
+			return queryFeeChanges(cdc, ctx, path[1:], req, keeper)
 		default:
 			return nil, sdkTypes.ErrUnknownRequest("unknown fee query endpoint")
 		}
@@ -197,3 +227,155 @@ func queryListFeeSettings(cdc *codec.Codec, ctx sdkTypes.Context, path []string,
 
 	return respData, nil
 }
+
+func queryFeeDistribution(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+	distribution, err := keeper.GetFeeDistribution(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	respData := cdc.MustMarshalJSON(distribution)
+
+	return respData, nil
+}
+
+func queryLastDistributed(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+	result, err := keeper.GetLastFeeDistribution(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	respData := cdc.MustMarshalJSON(result)
+
+	return respData, nil
+}
+
+func queryTotalDistributed(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+	result, err := keeper.GetTotalFeeDistribution(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	respData := cdc.MustMarshalJSON(result)
+
+	return respData, nil
+}
+
+func queryFeeToken(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+	if len(path) != 1 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	feeToken, err := keeper.GetFeeToken(ctx, path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	respData := cdc.MustMarshalJSON(feeToken)
+
+	return respData, nil
+}
+
+func queryListFeeTokens(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+	feeTokens := keeper.ListAllFeeTokens(ctx)
+
+	respData := cdc.MustMarshalJSON(feeTokens)
+
+	return respData, nil
+}
+
+func queryFeeAllowance(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+	if len(path) != 2 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	granter, err := sdkTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrInvalidAddress(path[0])
+	}
+
+	grantee, err := sdkTypes.AccAddressFromBech32(path[1])
+	if err != nil {
+		return nil, sdkTypes.ErrInvalidAddress(path[1])
+	}
+
+	allowance, allowanceErr := keeper.GetFeeAllowance(ctx, granter, grantee)
+	if allowanceErr != nil {
+		return nil, allowanceErr
+	}
+
+	respData := cdc.MustMarshalJSON(allowance)
+
+	return respData, nil
+}
+
+func queryListFeeAllowances(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+	if len(path) != 1 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	grantee, err := sdkTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrInvalidAddress(path[0])
+	}
+
+	allowances := keeper.ListFeeAllowances(ctx, grantee)
+
+	respData := cdc.MustMarshalJSON(allowances)
+
+	return respData, nil
+}
+
+func queryScheduledChanges(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+	changes := keeper.ListScheduledFeeChanges(ctx)
+
+	respData := cdc.MustMarshalJSON(changes)
+
+	return respData, nil
+}
+
+func queryFeeSettingAt(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+	if len(path) != 2 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	height, err := strconv.ParseInt(path[1], 10, 64)
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid height %s", path[1]))
+	}
+
+	feeSetting, feeSettingErr := keeper.GetFeeSettingAtHeight(ctx, path[0], height)
+	if feeSettingErr != nil {
+		return nil, feeSettingErr
+	}
+
+	respData := cdc.MustMarshalJSON(feeSetting)
+
+	return respData, nil
+}
+
+func queryFeeChanges(cdc *codec.Codec, ctx sdkTypes.Context, path []string, _ abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+	if len(path) != 2 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	fromHeight, err := strconv.ParseInt(path[0], 10, 64)
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid height %s", path[0]))
+	}
+
+	toHeight, err := strconv.ParseInt(path[1], 10, 64)
+	if err != nil {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid height %s", path[1]))
+	}
+
+	if fromHeight < 0 || toHeight < fromHeight {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid height range %d to %d", fromHeight, toHeight))
+	}
+
+	changes := keeper.ListFeeChanges(ctx, fromHeight, toHeight)
+
+	respData := cdc.MustMarshalJSON(changes)
+
+	return respData, nil
+}
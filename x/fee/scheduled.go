@@ -0,0 +1,177 @@
+package fee
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maxonrow/maxonrow-go/types"
+)
+
+var prefixScheduledFeeChange = []byte("0x0C")
+
+// Kinds of scheduled fee changes.
+const (
+	ScheduledFeeSetting      = "feeSetting"
+	ScheduledMultiplier      = "multiplier"
+	ScheduledTokenMultiplier = "tokenMultiplier"
+)
+
+// pending changes are keyed by height first, so the changes due are iterated in order.
+// Scheduling the same change again at the same height replaces it.
+func getScheduledFeeChangeKey(height int64, kind, name string) []byte {
+	return append(append(getScheduledFeeChangeHeightKey(height), []byte(kind)...), []byte(name)...)
+}
+
+func getScheduledFeeChangeHeightKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+
+	return append(append([]byte{}, prefixScheduledFeeChange...), bz...)
+}
+
+// ScheduledFeeChange is a fee setting or multiplier change waiting for its effective height.
+type ScheduledFeeChange struct {
+	EffectiveHeight int64               `json:"effective_height"`
+	Kind            string              `json:"kind"`
+	FeeSetting      *FeeSetting         `json:"fee_setting,omitempty"`
+	Multiplier      string              `json:"multiplier,omitempty"`
+	Issuer          sdkTypes.AccAddress `json:"issuer"`
+}
+
+func (c ScheduledFeeChange) key() []byte {
+	name := ""
+	if c.FeeSetting != nil {
+		name = c.FeeSetting.Name
+	}
+
+	return getScheduledFeeChangeKey(c.EffectiveHeight, c.Kind, name)
+}
+
+// describe returns the name of the fee setting or the multiplier being changed, for events.
+func (c ScheduledFeeChange) describe() string {
+	if c.FeeSetting != nil {
+		return c.FeeSetting.Name
+	}
+
+	return c.Multiplier
+}
+
+func (c ScheduledFeeChange) ValidateBasic() sdkTypes.Error {
+	switch c.Kind {
+	case ScheduledFeeSetting:
+		if c.FeeSetting == nil {
+			return sdkTypes.ErrUnknownRequest("Scheduled fee setting cant be empty.")
+		}
+	case ScheduledMultiplier, ScheduledTokenMultiplier:
+		multiplier, err := sdkTypes.NewDecFromStr(c.Multiplier)
+		if err != nil {
+			return err
+		}
+		if !multiplier.IsPositive() {
+			return sdkTypes.ErrInternal("Multiplier invalid.")
+		}
+	default:
+		return sdkTypes.ErrUnknownRequest("Unknown scheduled fee change: " + c.Kind)
+	}
+
+	if c.EffectiveHeight <= 0 {
+		return sdkTypes.ErrUnknownRequest("Effective height must be positive.")
+	}
+
+	return nil
+}
+
+func isScheduled(ctx sdkTypes.Context, effectiveHeight int64) bool {
+	return effectiveHeight > ctx.BlockHeight()
+}
+
+func (k *Keeper) scheduleFeeChange(ctx sdkTypes.Context, change ScheduledFeeChange) sdkTypes.Result {
+	err := k.storeScheduledFeeChange(ctx, change)
+	if err != nil {
+		return err.Result()
+	}
+
+	eventParam := []string{change.Kind, change.describe(), strconv.FormatInt(change.EffectiveHeight, 10)}
+	eventSignature := "ScheduledFeeChange(string,string,string)"
+
+	return sdkTypes.Result{
+		Events: types.MakeMxwEvents(eventSignature, change.Issuer.String(), eventParam),
+	}
+}
+
+func (k *Keeper) storeScheduledFeeChange(ctx sdkTypes.Context, change ScheduledFeeChange) sdkTypes.Error {
+	err := change.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Set(change.key(), k.cdc.MustMarshalBinaryLengthPrefixed(change))
+
+	return nil
+}
+
+// ListScheduledFeeChanges returns the pending fee changes ordered by effective height.
+func (k *Keeper) ListScheduledFeeChanges(ctx sdkTypes.Context) []ScheduledFeeChange {
+	store := ctx.KVStore(k.key)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixScheduledFeeChange)
+	defer iter.Close()
+
+	var changes []ScheduledFeeChange
+	for ; iter.Valid(); iter.Next() {
+		var change ScheduledFeeChange
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &change)
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+func (k *Keeper) isFeeSettingScheduled(ctx sdkTypes.Context, feeName string) bool {
+	for _, change := range k.ListScheduledFeeChanges(ctx) {
+		if change.Kind == ScheduledFeeSetting && change.FeeSetting.Name == feeName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ApplyScheduledFeeChanges applies the fee changes due at the current height, it is called in BeginBlock.
+func (k *Keeper) ApplyScheduledFeeChanges(ctx sdkTypes.Context) sdkTypes.Events {
+	store := ctx.KVStore(k.key)
+	iter := store.Iterator(getScheduledFeeChangeHeightKey(0), getScheduledFeeChangeHeightKey(ctx.BlockHeight()+1))
+
+	var changes []ScheduledFeeChange
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		var change ScheduledFeeChange
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &change)
+		changes = append(changes, change)
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	var events sdkTypes.Events
+	for i, change := range changes {
+		switch change.Kind {
+		case ScheduledFeeSetting:
+			feeSetting := change.FeeSetting
+			msg := NewMsgSysFeeSetting(feeSetting.Name, feeSetting.Min, feeSetting.Max, feeSetting.Percentage, feeSetting.Issuer)
+			msg.Brackets = feeSetting.Brackets
+			k.updateFeeSetting(ctx, msg)
+		case ScheduledMultiplier:
+			k.updateFeeMultiplier(ctx, change.Multiplier, change.Issuer)
+		case ScheduledTokenMultiplier:
+			k.updateTokenFeeMultiplier(ctx, change.Multiplier, change.Issuer)
+		}
+		store.Delete(keys[i])
+
+		eventParam := []string{change.Kind, change.describe(), strconv.FormatInt(change.EffectiveHeight, 10)}
+		eventSignature := "AppliedFeeChange(string,string,string)"
+		events = events.AppendEvents(types.MakeMxwEvents(eventSignature, change.Issuer.String(), eventParam))
+	}
+
+	return events
+}
@@ -0,0 +1,123 @@
+package fee
+
+import (
+	"fmt"
+	"strings"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maxonrow/maxonrow-go/types"
+)
+
+// FeeBracket is one bracket of a tiered fee setting, it applies to amounts up to the threshold.
+// Empty threshold means no upper bound, only the last bracket can have it.
+// The bracket charges either a percentage of the amount or a flat fee.
+type FeeBracket struct {
+	Threshold  sdkTypes.Coins `json:"threshold"`
+	Percentage string         `json:"percentage,omitempty"`
+	Flat       sdkTypes.Coins `json:"flat,omitempty"`
+}
+
+// ParseFeeBracket parses a bracket in threshold=fee format, where fee is a percentage or a flat amount in cin.
+// For example 1000cin=0.1 or =5000cin for the last bracket.
+func ParseFeeBracket(str string) (FeeBracket, error) {
+	parts := strings.Split(str, "=")
+	if len(parts) != 2 {
+		return FeeBracket{}, fmt.Errorf("Invalid fee bracket %s, expected threshold=fee", str)
+	}
+
+	var bracket FeeBracket
+	if parts[0] != "" {
+		threshold, err := sdkTypes.ParseCoins(parts[0])
+		if err != nil {
+			return FeeBracket{}, err
+		}
+		bracket.Threshold = threshold
+	}
+
+	if strings.HasSuffix(parts[1], types.CIN) {
+		flat, err := sdkTypes.ParseCoins(parts[1])
+		if err != nil {
+			return FeeBracket{}, err
+		}
+		bracket.Flat = flat
+	} else {
+		bracket.Percentage = parts[1]
+	}
+
+	return bracket, nil
+}
+
+func validateFeeBrackets(brackets []FeeBracket) sdkTypes.Error {
+	previous := sdkTypes.ZeroInt()
+	for i, bracket := range brackets {
+		if bracket.Threshold.Empty() {
+			if i != len(brackets)-1 {
+				return sdkTypes.ErrUnknownRequest("Only the last fee bracket can be without threshold.")
+			}
+		} else {
+			if !bracket.Threshold.IsValid() {
+				return sdkTypes.ErrInvalidCoins(bracket.Threshold.String())
+			}
+			if !isCinAmount(bracket.Threshold) {
+				return sdkTypes.ErrInvalidCoins("Fee bracket threshold must be in cin.")
+			}
+			threshold := bracket.Threshold.AmountOf(types.CIN)
+			if !threshold.GT(previous) {
+				return sdkTypes.ErrUnknownRequest("Fee bracket thresholds must be increasing.")
+			}
+			previous = threshold
+		}
+
+		if (bracket.Percentage == "") == bracket.Flat.Empty() {
+			return sdkTypes.ErrUnknownRequest("Fee bracket must have either a percentage or a flat fee.")
+		}
+
+		if bracket.Percentage != "" {
+			percentage, err := sdkTypes.NewDecFromStr(bracket.Percentage)
+			if err != nil {
+				return err
+			}
+			if percentage.IsNegative() {
+				return sdkTypes.ErrUnknownRequest("Fee bracket percentage cant be negative.")
+			}
+		} else if !bracket.Flat.IsValid() {
+			return sdkTypes.ErrInvalidCoins(bracket.Flat.String())
+		} else if !isCinAmount(bracket.Flat) {
+			return sdkTypes.ErrInvalidCoins("Fee bracket flat fee must be in cin.")
+		}
+	}
+
+	if len(brackets) > 0 && !brackets[len(brackets)-1].Threshold.Empty() {
+		return sdkTypes.ErrUnknownRequest("The last fee bracket must be without threshold.")
+	}
+
+	return nil
+}
+
+// isCinAmount tells if the coins are an amount of cin only, the bracket fee is calculated in cin.
+func isCinAmount(coins sdkTypes.Coins) bool {
+	return len(coins) == 1 && coins[0].Denom == types.CIN
+}
+
+// IsTiered tells if the fee setting charges by brackets instead of a single percentage.
+func (feeSetting *FeeSetting) IsTiered() bool {
+	return len(feeSetting.Brackets) > 0
+}
+
+// calculateBracketFee returns the fee of the first bracket the amount fits in.
+func calculateBracketFee(brackets []FeeBracket, amount sdkTypes.Int) sdkTypes.Int {
+	for _, bracket := range brackets {
+		if !bracket.Threshold.Empty() && amount.GT(bracket.Threshold.AmountOf(types.CIN)) {
+			continue
+		}
+
+		if bracket.Percentage == "" {
+			return bracket.Flat.AmountOf(types.CIN)
+		}
+
+		percentage := sdkTypes.MustNewDecFromStr(bracket.Percentage)
+		return amount.ToDec().Mul(percentage).Quo(sdkTypes.MustNewDecFromStr("100.0")).RoundInt()
+	}
+
+	return sdkTypes.ZeroInt()
+}
@@ -0,0 +1,165 @@
+package kyc
+
+import (
+	"fmt"
+	"strconv"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxKycBindings is the number of wallets that can be bound to a kyc address, besides the whitelisted one.
+const MaxKycBindings = 10
+
+var prefixKycBinding = []byte("0x0c")
+var prefixKycBindingWallets = []byte("0x0d")
+
+func getKycBindingKey(addr sdkTypes.AccAddress) []byte {
+	return append(append([]byte{}, prefixKycBinding...), addr.Bytes()...)
+}
+
+func getKycBindingWalletKey(kycAddress string, addr sdkTypes.AccAddress) []byte {
+	return append(getKycBindingWalletsPrefix(kycAddress), addr.Bytes()...)
+}
+
+// a kyc address can't contain ':', so it ends the kyc address in the key.
+func getKycBindingWalletsPrefix(kycAddress string) []byte {
+	return append(append(append([]byte{}, prefixKycBindingWallets...), []byte(kycAddress)...), ':')
+}
+
+// KycBinding is a wallet bound to the kyc address of a whitelisted account.
+type KycBinding struct {
+	Address    sdkTypes.AccAddress `json:"address"`
+	KycAddress string              `json:"kyc_address"`
+}
+
+// ValidateKycBind checks the signer is whitelisted with the kyc address and the wallet can be bound to it.
+// The wallet must sign the bind with its sequence as the nonce, its sequence is bumped so the signature can't be replayed.
+func (k Keeper) ValidateKycBind(ctx sdkTypes.Context, msg MsgKycBind) sdkTypes.Error {
+	if !k.IsWhitelisted(ctx, msg.From) {
+		return sdkTypes.ErrUnauthorized("Singer is not whitelisted.")
+	}
+
+	if string(k.GetKycAddress(ctx, msg.From)) != msg.KycAddress {
+		return sdkTypes.ErrUnauthorized("Kyc address does not belong to the signer.")
+	}
+
+	if k.IsWhitelisted(ctx, msg.To) {
+		return sdkTypes.ErrUnknownRequest("Wallet is whitelisted already.")
+	}
+
+	if k.IsKycBound(ctx, msg.To) {
+		return sdkTypes.ErrUnknownRequest("Wallet is bound to a kyc address already.")
+	}
+
+	if len(k.GetKycBindings(ctx, msg.KycAddress)) >= MaxKycBindings {
+		return sdkTypes.ErrUnknownRequest(fmt.Sprintf("Kyc address has %d bound wallets already.", MaxKycBindings))
+	}
+
+	if msg.Signature.PubKey == nil || !msg.To.Equals(sdkTypes.AccAddress(msg.Signature.PubKey.Address())) {
+		return sdkTypes.ErrUnauthorized("Wallet signature is required.")
+	}
+
+	toAcc := k.accountKeeper.GetAccount(ctx, msg.To)
+	if toAcc == nil {
+		toAcc = k.accountKeeper.NewAccountWithAddress(ctx, msg.To)
+	}
+
+	nonce, nonceErr := strconv.ParseUint(msg.Nonce, 10, 64)
+	if nonceErr != nil || nonce != toAcc.GetSequence() {
+		return sdkTypes.ErrInvalidSequence("Wallet signature is invalid.")
+	}
+
+	if !(processSig(toAcc, msg.Signature, msg.GetToSignBytes())) {
+		return sdkTypes.ErrUnauthorized("Wallet signature verification failed.")
+	}
+
+	toAcc.SetSequence(toAcc.GetSequence() + 1)
+	k.accountKeeper.SetAccount(ctx, toAcc)
+
+	return nil
+}
+
+// ValidateKycUnbind checks the wallet is bound to the kyc address, either the whitelisted account or the wallet itself can unbind it.
+func (k Keeper) ValidateKycUnbind(ctx sdkTypes.Context, msg MsgKycUnbind) sdkTypes.Error {
+	if k.GetKycBinding(ctx, msg.To) != msg.KycAddress {
+		return sdkTypes.ErrUnknownRequest("Wallet is not bound to the kyc address.")
+	}
+
+	if msg.From.Equals(msg.To) {
+		return nil
+	}
+
+	if !k.IsWhitelisted(ctx, msg.From) || string(k.GetKycAddress(ctx, msg.From)) != msg.KycAddress {
+		return sdkTypes.ErrUnauthorized("Kyc address does not belong to the signer.")
+	}
+
+	return nil
+}
+
+func (k Keeper) KycBind(ctx sdkTypes.Context, addr sdkTypes.AccAddress, kycAddress string) {
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	store.Set(getKycBindingKey(addr), []byte(kycAddress))
+	store.Set(getKycBindingWalletKey(kycAddress, addr), []byte{1})
+}
+
+func (k Keeper) KycUnbind(ctx sdkTypes.Context, addr sdkTypes.AccAddress) {
+	kycAddress := k.GetKycBinding(ctx, addr)
+	if len(kycAddress) == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	store.Delete(getKycBindingKey(addr))
+	store.Delete(getKycBindingWalletKey(kycAddress, addr))
+}
+
+// IsKycBound tells if the wallet is bound to the kyc address of a whitelisted account.
+func (k Keeper) IsKycBound(ctx sdkTypes.Context, addr sdkTypes.AccAddress) bool {
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	return store.Has(getKycBindingKey(addr))
+}
+
+// GetKycBinding returns the kyc address the wallet is bound to, empty if it is not bound.
+func (k Keeper) GetKycBinding(ctx sdkTypes.Context, addr sdkTypes.AccAddress) string {
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	return string(store.Get(getKycBindingKey(addr)))
+}
+
+// GetKycBindings returns the wallets bound to the kyc address.
+func (k Keeper) GetKycBindings(ctx sdkTypes.Context, kycAddress string) []sdkTypes.AccAddress {
+	prefix := getKycBindingWalletsPrefix(kycAddress)
+
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	var addresses []sdkTypes.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		addresses = append(addresses, sdkTypes.AccAddress(iter.Key()[len(prefix):]))
+	}
+
+	return addresses
+}
+
+func (k Keeper) ListAllKycBindings(ctx sdkTypes.Context) []KycBinding {
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixKycBinding)
+	defer iter.Close()
+
+	var bindings []KycBinding
+	for ; iter.Valid(); iter.Next() {
+		bindings = append(bindings, KycBinding{
+			Address:    sdkTypes.AccAddress(iter.Key()[len(prefixKycBinding):]),
+			KycAddress: string(iter.Value()),
+		})
+	}
+
+	return bindings
+}
+
+// unbindAll unbinds the wallets of the kyc address, when the whitelisted account of it is revoked.
+func (k Keeper) unbindAll(ctx sdkTypes.Context, kycAddress string) {
+	for _, addr := range k.GetKycBindings(ctx, kycAddress) {
+		k.KycUnbind(ctx, addr)
+	}
+}
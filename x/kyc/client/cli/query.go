@@ -6,6 +6,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagPage  = "page"
+	flagLimit = "limit"
 )
 
 func GetCmdIsWhitelisted(queryRoute string, cdc *codec.Codec) *cobra.Command {
@@ -34,3 +40,189 @@ func GetCmdIsWhitelisted(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetCmdWhitelistExpiry(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "expiry [address]",
+		Short: "get the expiry height and time of a whitelisted address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			addressStr := args[0]
+
+			// To prevent invalid address going to the server
+			if _, err := sdkTypes.AccAddressFromBech32(addressStr); err != nil {
+				return sdkTypes.ErrInvalidAddress(err.Error())
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/get_whitelist_expiry/%s", queryRoute, addressStr), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+func GetCmdKycTier(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tier [address]",
+		Short: "get the kyc tier, transfer limits and transferred amounts of a whitelisted address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			addressStr := args[0]
+
+			// To prevent invalid address going to the server
+			if _, err := sdkTypes.AccAddressFromBech32(addressStr); err != nil {
+				return sdkTypes.ErrInvalidAddress(err.Error())
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/get_kyc_tier/%s", queryRoute, addressStr), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+func GetCmdTierLimits(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tier-limits",
+		Short: "list the transfer limits of the kyc tiers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list_tier_limits", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+func GetCmdKycBinding(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "binding [address]",
+		Short: "get the kyc address a wallet is bound to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			addressStr := args[0]
+
+			// To prevent invalid address going to the server
+			if _, err := sdkTypes.AccAddressFromBech32(addressStr); err != nil {
+				return sdkTypes.ErrInvalidAddress(err.Error())
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/get_kyc_binding/%s", queryRoute, addressStr), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+func GetCmdKycBindings(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bindings [kyc address]",
+		Short: "list the wallets bound to a kyc address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/get_kyc_bindings/%s", queryRoute, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+func GetCmdListWhitelisted(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whitelisted",
+		Short: "list a page of the whitelisted addresses with their kyc address and whitelisted height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			page := viper.GetInt(flagPage)
+			limit := viper.GetInt(flagLimit)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/list_whitelisted/%d/%d", queryRoute, page, limit), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(flagPage, 1, "Page of the whitelisted addresses, starts at 1")
+	cmd.Flags().Int(flagLimit, 100, "Number of whitelisted addresses of a page")
+
+	return cmd
+}
+
+func GetCmdWhitelistedAddress(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "wallet [kyc address]",
+		Short: "get the address whitelisted with a kyc address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/get_whitelisted_address/%s", queryRoute, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
+
+func GetCmdListRole(queryRoute string, cdc *codec.Codec, role, path string) *cobra.Command {
+	return &cobra.Command{
+		Use:   role,
+		Short: fmt.Sprintf("list the %s addresses of kyc", role),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, path), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+
+			return nil
+		},
+	}
+}
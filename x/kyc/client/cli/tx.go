@@ -1,47 +1,413 @@
 package cli
 
 import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	sdkAuth "github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/maxonrow/maxonrow-go/x/kyc"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagNonce        = "nonce"
+	flagExpiryHeight = "expiry-height"
+	flagExpiryTime   = "expiry-time"
+	flagTier         = "tier"
 )
 
+// Whitelisting is signed by several parties offline, each step reads the file of the step before and prints JSON:
+//   1. whitelist-payload      creates the kyc of the wallet
+//   2. sign-whitelist-payload the wallet signs its kyc into the payload
+//   3. sign-whitelist         a provider and an issuer sign the payload, each into a signature file
+//   4. whitelist              an authorised address broadcasts the payload with the signatures
+// Revoking is the same, a provider creates and signs the payload with revoke-payload.
+// Binding a wallet is signed by the wallet with sign-bind, then the whitelisted account broadcasts it with bind.
+
+func GetCmdWhitelistPayload(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whitelist-payload [address] [kyc address]",
+		Short: "create the unsigned kyc of a wallet to whitelist, the nonce is the sequence of the wallet",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			nonce, err := getNonce(cliCtx, address)
+			if err != nil {
+				return err
+			}
+
+			kycInfo := kyc.NewKyc(address, nonce, args[1])
+			kycInfo.ExpiryHeight = viper.GetInt64(flagExpiryHeight)
+			kycInfo.ExpiryTime = viper.GetInt64(flagExpiryTime)
+			kycInfo.Tier = viper.GetString(flagTier)
+
+			return printJSON(cdc, kycInfo)
+		},
+	}
+
+	cmd.Flags().Int64(flagNonce, -1, "Sequence of the wallet, it is queried if not set")
+	cmd.Flags().Int64(flagExpiryHeight, 0, "Block height the kyc expires at, 0 for no expiry")
+	cmd.Flags().Int64(flagExpiryTime, 0, "Block time in unix seconds the kyc expires at, 0 for no expiry")
+	cmd.Flags().String(flagTier, "", "Kyc tier of the transfer limits, empty for no limits")
+
+	return cmd
+}
+
+func GetCmdSignWhitelistPayload(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-whitelist-payload [kyc file]",
+		Short: "sign the kyc as the wallet to whitelist, and create the payload for the issuers to sign",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var kycInfo kyc.Kyc
+			if err := readJSONFile(cdc, args[0], &kycInfo); err != nil {
+				return err
+			}
+
+			if !kycInfo.From.Equals(cliCtx.GetFromAddress()) {
+				return fmt.Errorf("The kyc is of %s, it can't be signed by %s", kycInfo.From, cliCtx.GetFromAddress())
+			}
+
+			signature, err := signBytes(cmd, cliCtx, kycInfo.GetFromSignBytes())
+			if err != nil {
+				return err
+			}
+
+			return printJSON(cdc, kyc.NewPayload(kycInfo, signature.PubKey, signature.Signature))
+		},
+	}
+
+	return cmd
+}
+
+func GetCmdSignWhitelist(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-whitelist [payload file]",
+		Short: "sign the whitelist payload as a provider or an issuer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var payload kyc.Payload
+			if err := readJSONFile(cdc, args[0], &payload); err != nil {
+				return err
+			}
+
+			signature, err := signBytes(cmd, cliCtx, payload.GetIssuerSignBytes())
+			if err != nil {
+				return err
+			}
+
+			return printJSON(cdc, signature)
+		},
+	}
+
+	return cmd
+}
+
 func GetCmdWhitelist(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "whitelist [address] [kyc address]",
-		Short: "whitelist address from authorised address",
+		Use:   "whitelist [payload file] [signature file]...",
+		Short: "whitelist address from authorised address, with the signatures of a provider and an issuer",
+		Args:  cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := sdkAuth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var payload kyc.Payload
+			if err := readJSONFile(cdc, args[0], &payload); err != nil {
+				return err
+			}
+
+			signatures, err := readSignatures(cdc, args[1:])
+			if err != nil {
+				return err
+			}
+
+			msg := kyc.NewMsgWhitelist(cliCtx.GetFromAddress(), kyc.NewKycData(payload, signatures))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdkTypes.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func GetCmdRevokePayload(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-payload [address]",
+		Short: "create and sign the payload to revoke a whitelisted address as a provider, the nonce is the sequence of the provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			target, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			nonce, err := getNonce(cliCtx, cliCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			revokeKycData := kyc.NewRevokeKycData(cliCtx.GetFromAddress(), nonce, target)
+			signature, err := signBytes(cmd, cliCtx, revokeKycData.GetRevokeFromSignBytes())
+			if err != nil {
+				return err
+			}
+
+			return printJSON(cdc, kyc.NewRevokePayload(revokeKycData, signature.PubKey, signature.Signature))
+		},
+	}
+
+	cmd.Flags().Int64(flagNonce, -1, "Sequence of the provider, it is queried if not set")
+
+	return cmd
+}
+
+func GetCmdSignRevoke(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-revoke [revoke payload file]",
+		Short: "sign the revoke payload as an issuer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var revokePayload kyc.RevokePayload
+			if err := readJSONFile(cdc, args[0], &revokePayload); err != nil {
+				return err
+			}
+
+			signature, err := signBytes(cmd, cliCtx, revokePayload.GetRevokeIssuerSignBytes())
+			if err != nil {
+				return err
+			}
+
+			return printJSON(cdc, signature)
+		},
+	}
+
+	return cmd
+}
+
+func GetCmdRevokeWhitelist(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [revoke payload file] [signature file]...",
+		Short: "revoke a whitelisted address from authorised address, with the signatures of the issuers",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := sdkAuth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var revokePayload kyc.RevokePayload
+			if err := readJSONFile(cdc, args[0], &revokePayload); err != nil {
+				return err
+			}
+
+			signatures, err := readSignatures(cdc, args[1:])
+			if err != nil {
+				return err
+			}
+
+			msg := kyc.NewMsgRevokeWhitelist(cliCtx.GetFromAddress(), revokePayload, signatures)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdkTypes.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func GetCmdUpgradeKycTier(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-tier [address] [tier]",
+		Short: "change the kyc tier of a whitelisted address from authorised address, an empty tier removes its transfer limits",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := sdkAuth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			// txBldr := authTypes.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			target, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
 
-			// signer := cliCtx.GetFromName()
+			msg := kyc.NewMsgUpgradeKycTier(cliCtx.GetFromAddress(), target, args[1])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdkTypes.Msg{msg})
+		},
+	}
 
-			// recipient, err := sdkTypes.AccAddressFromBech32(args[0])
-			// if err != nil {
-			// 	return err
-			// }
-			// kycAddress := args[1]
-			// walletSignature, walletErr := sdkTypes.AccAddressFromBech32(args[2])
-			// if walletErr != nil {
-			// 	return walletErr
-			// }
-			// kyc := kyc.NewKyc(recipient, "0", kycAddress)
-			//* fix populating data
-			// kycPayload := kyc.NewPayload(recipient, "", kycAddress)
-			// kycData := kyc.NewKycData(kycPayload, []byte{walletSignature}, crypto.PubKey{nil})
-			// msg := kyc.NewMsgWhitelist(sender, kycData, []kyc.Signature{nil})
-			// if err := msg.ValidateBasic(); err != nil {
-			// 	return err
-			// }
+	return cmd
+}
 
-			// return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdkTypes.Msg{})
-			return nil
+func GetCmdSignKycBind(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-bind [whitelisted address] [kyc address]",
+		Short: "sign the bind of the wallet to a kyc address, the nonce is the sequence of the wallet",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			from, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			nonce, err := getNonce(cliCtx, cliCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg := kyc.NewMsgKycBind(from, cliCtx.GetFromAddress(), args[1], nonce)
+			msg.Signature, err = signBytes(cmd, cliCtx, msg.GetToSignBytes())
+			if err != nil {
+				return err
+			}
+
+			return printJSON(cdc, msg)
 		},
 	}
 
-	cmd.Flags().String("issuer-address", "", "Issuer address")
-	cmd.Flags().String("provider-address", "", "Provider Address")
+	cmd.Flags().Int64(flagNonce, -1, "Sequence of the wallet, it is queried if not set")
+
+	return cmd
+}
+
+func GetCmdKycBind(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bind [bind file]",
+		Short: "bind a wallet to the kyc address the signer is whitelisted with, the wallet signs the bind with sign-bind",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := sdkAuth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var msg kyc.MsgKycBind
+			if err := readJSONFile(cdc, args[0], &msg); err != nil {
+				return err
+			}
+
+			if !msg.From.Equals(cliCtx.GetFromAddress()) {
+				return fmt.Errorf("The bind is to the kyc address of %s, it can't be sent by %s", msg.From, cliCtx.GetFromAddress())
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdkTypes.Msg{msg})
+		},
+	}
 
 	return cmd
 }
+
+func GetCmdKycUnbind(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbind [address] [kyc address]",
+		Short: "unbind a wallet from a kyc address, by the whitelisted account of the kyc address or the wallet itself",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := sdkAuth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			to, err := sdkTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := kyc.NewMsgKycUnbind(cliCtx.GetFromAddress(), to, args[1])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdkTypes.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// getNonce returns the nonce flag, or the sequence of the account, a wallet without account yet has 0.
+func getNonce(cliCtx context.CLIContext, address sdkTypes.AccAddress) (string, error) {
+	if nonce := viper.GetInt64(flagNonce); nonce >= 0 {
+		return strconv.FormatInt(nonce, 10), nil
+	}
+
+	accGetter := authTypes.NewAccountRetriever(cliCtx)
+	if err := accGetter.EnsureExists(address); err != nil {
+		return "0", nil
+	}
+
+	_, sequence, err := accGetter.GetAccountNumberSequence(address)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatUint(sequence, 10), nil
+}
+
+// signBytes signs with the key of the from flag, the kyc payloads are signed as they are, not as a tx.
+func signBytes(cmd *cobra.Command, cliCtx context.CLIContext, bz []byte) (kyc.Signature, error) {
+	txBldr := sdkAuth.NewTxBuilderFromCLI(bufio.NewReader(cmd.InOrStdin()))
+
+	sig, pubKey, err := txBldr.Keybase().Sign(cliCtx.GetFromName(), client.DefaultKeyPass, bz)
+	if err != nil {
+		return kyc.Signature{}, err
+	}
+
+	return kyc.NewSignature(pubKey, sig), nil
+}
+
+func readSignatures(cdc *codec.Codec, files []string) ([]kyc.Signature, error) {
+	var signatures []kyc.Signature
+	for _, file := range files {
+		var signature kyc.Signature
+		if err := readJSONFile(cdc, file, &signature); err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, signature)
+	}
+
+	return signatures, nil
+}
+
+func readJSONFile(cdc *codec.Codec, file string, ptr interface{}) error {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	return cdc.UnmarshalJSON(bz, ptr)
+}
+
+func printJSON(cdc *codec.Codec, obj interface{}) error {
+	bz, err := cdc.MarshalJSONIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(bz))
+	return nil
+}
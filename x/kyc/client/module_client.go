@@ -27,6 +27,16 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(client.GetCommands(
 		kyccli.GetCmdIsWhitelisted(mc.storeKey, mc.cdc),
+		kyccli.GetCmdWhitelistExpiry(mc.storeKey, mc.cdc),
+		kyccli.GetCmdKycTier(mc.storeKey, mc.cdc),
+		kyccli.GetCmdTierLimits(mc.storeKey, mc.cdc),
+		kyccli.GetCmdKycBinding(mc.storeKey, mc.cdc),
+		kyccli.GetCmdKycBindings(mc.storeKey, mc.cdc),
+		kyccli.GetCmdListWhitelisted(mc.storeKey, mc.cdc),
+		kyccli.GetCmdWhitelistedAddress(mc.storeKey, mc.cdc),
+		kyccli.GetCmdListRole(mc.storeKey, mc.cdc, "authorised", "list_authorised"),
+		kyccli.GetCmdListRole(mc.storeKey, mc.cdc, "issuers", "list_issuers"),
+		kyccli.GetCmdListRole(mc.storeKey, mc.cdc, "providers", "list_providers"),
 	)...)
 
 	return queryCmd
@@ -39,7 +49,17 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 	}
 
 	txCmd.AddCommand(client.PostCommands(
+		kyccli.GetCmdWhitelistPayload(mc.cdc),
+		kyccli.GetCmdSignWhitelistPayload(mc.cdc),
+		kyccli.GetCmdSignWhitelist(mc.cdc),
 		kyccli.GetCmdWhitelist(mc.cdc),
+		kyccli.GetCmdRevokePayload(mc.cdc),
+		kyccli.GetCmdSignRevoke(mc.cdc),
+		kyccli.GetCmdRevokeWhitelist(mc.cdc),
+		kyccli.GetCmdUpgradeKycTier(mc.cdc),
+		kyccli.GetCmdSignKycBind(mc.cdc),
+		kyccli.GetCmdKycBind(mc.cdc),
+		kyccli.GetCmdKycUnbind(mc.cdc),
 	)...)
 
 	return txCmd
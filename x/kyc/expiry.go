@@ -0,0 +1,139 @@
+package kyc
+
+import (
+	"encoding/binary"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maxonrow/maxonrow-go/types"
+)
+
+var prefixWhitelistExpiry = []byte("0x06")
+var prefixWhitelistExpiryHeightQueue = []byte("0x07")
+var prefixWhitelistExpiryTimeQueue = []byte("0x08")
+
+func getWhitelistExpiryKey(addr sdkTypes.AccAddress) []byte {
+	return append(append([]byte{}, prefixWhitelistExpiry...), addr.Bytes()...)
+}
+
+// the queues are keyed by the expiry first, so the entries expired at a height or time are iterated in order.
+func getWhitelistExpiryQueueKey(prefix []byte, expiry int64, addr sdkTypes.AccAddress) []byte {
+	return append(getWhitelistExpiryQueuePrefix(prefix, expiry), addr.Bytes()...)
+}
+
+func getWhitelistExpiryQueuePrefix(prefix []byte, expiry int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(expiry))
+
+	return append(append([]byte{}, prefix...), bz...)
+}
+
+// WhitelistExpiry is when the kyc of a whitelisted account expires, at a block height, a block time in unix seconds or whichever comes first.
+// Zero means it doesn't expire by it.
+type WhitelistExpiry struct {
+	ExpiryHeight int64 `json:"expiry_height,omitempty"`
+	ExpiryTime   int64 `json:"expiry_time,omitempty"`
+}
+
+func (expiry WhitelistExpiry) IsEmpty() bool {
+	return expiry.ExpiryHeight == 0 && expiry.ExpiryTime == 0
+}
+
+// IsExpired tells if the expiry is reached at the height and time of the context.
+func (expiry WhitelistExpiry) IsExpired(ctx sdkTypes.Context) bool {
+	if expiry.ExpiryHeight != 0 && ctx.BlockHeight() >= expiry.ExpiryHeight {
+		return true
+	}
+
+	return expiry.ExpiryTime != 0 && ctx.BlockHeader().Time.Unix() >= expiry.ExpiryTime
+}
+
+// ValidateWhitelistExpiry checks the expiry is not reached yet, an empty expiry is valid.
+func ValidateWhitelistExpiry(ctx sdkTypes.Context, expiry WhitelistExpiry) sdkTypes.Error {
+	if expiry.ExpiryHeight < 0 || expiry.ExpiryTime < 0 {
+		return sdkTypes.ErrUnknownRequest("Expiry cannot be negative.")
+	}
+
+	if expiry.IsExpired(ctx) {
+		return sdkTypes.ErrUnknownRequest("Expiry is already reached.")
+	}
+
+	return nil
+}
+
+// SetWhitelistExpiry replaces the expiry of the whitelisted account, an empty expiry removes it.
+func (k Keeper) SetWhitelistExpiry(ctx sdkTypes.Context, addr sdkTypes.AccAddress, expiry WhitelistExpiry) {
+	k.deleteWhitelistExpiry(ctx, addr)
+	if expiry.IsEmpty() {
+		return
+	}
+
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	store.Set(getWhitelistExpiryKey(addr), k.cdc.MustMarshalBinaryLengthPrefixed(expiry))
+
+	if expiry.ExpiryHeight != 0 {
+		store.Set(getWhitelistExpiryQueueKey(prefixWhitelistExpiryHeightQueue, expiry.ExpiryHeight, addr), []byte{1})
+	}
+	if expiry.ExpiryTime != 0 {
+		store.Set(getWhitelistExpiryQueueKey(prefixWhitelistExpiryTimeQueue, expiry.ExpiryTime, addr), []byte{1})
+	}
+}
+
+// GetWhitelistExpiry returns the expiry of the whitelisted account, empty if it doesn't expire.
+func (k Keeper) GetWhitelistExpiry(ctx sdkTypes.Context, addr sdkTypes.AccAddress) WhitelistExpiry {
+	var expiry WhitelistExpiry
+
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	bz := store.Get(getWhitelistExpiryKey(addr))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &expiry)
+	}
+
+	return expiry
+}
+
+func (k Keeper) deleteWhitelistExpiry(ctx sdkTypes.Context, addr sdkTypes.AccAddress) {
+	expiry := k.GetWhitelistExpiry(ctx, addr)
+	if expiry.IsEmpty() {
+		return
+	}
+
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	store.Delete(getWhitelistExpiryKey(addr))
+	store.Delete(getWhitelistExpiryQueueKey(prefixWhitelistExpiryHeightQueue, expiry.ExpiryHeight, addr))
+	store.Delete(getWhitelistExpiryQueueKey(prefixWhitelistExpiryTimeQueue, expiry.ExpiryTime, addr))
+}
+
+// RevokeExpiredWhitelists revokes the whitelisted accounts expired at the current height or time, it is called in EndBlock.
+func (k Keeper) RevokeExpiredWhitelists(ctx sdkTypes.Context) sdkTypes.Events {
+	expired := k.iterateExpiryQueue(ctx, prefixWhitelistExpiryHeightQueue, ctx.BlockHeight())
+	expired = append(expired, k.iterateExpiryQueue(ctx, prefixWhitelistExpiryTimeQueue, ctx.BlockHeader().Time.Unix())...)
+
+	events := sdkTypes.EmptyEvents()
+	for _, addr := range expired {
+		// an account in both queues is revoked once
+		if k.GetWhitelistExpiry(ctx, addr).IsEmpty() {
+			continue
+		}
+
+		kycAddress := k.removeWhitelist(ctx, addr)
+
+		eventParam := []string{addr.String(), string(kycAddress)}
+		eventSignature := "RevokedWhitelist(string,string)"
+		events = events.AppendEvents(types.MakeMxwEvents(eventSignature, addr.String(), eventParam))
+	}
+
+	return events
+}
+
+func (k Keeper) iterateExpiryQueue(ctx sdkTypes.Context, prefix []byte, until int64) []sdkTypes.AccAddress {
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	iter := store.Iterator(getWhitelistExpiryQueuePrefix(prefix, 0), getWhitelistExpiryQueuePrefix(prefix, until+1))
+	defer iter.Close()
+
+	var addresses []sdkTypes.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		addresses = append(addresses, sdkTypes.AccAddress(iter.Key()[len(prefix)+8:]))
+	}
+
+	return addresses
+}
@@ -7,6 +7,19 @@ type GenesisState struct {
 	IssuerAddresses      []sdkTypes.AccAddress `json:"issuer_addresses"`
 	ProviderAddresses    []sdkTypes.AccAddress `json:"provider_addresses"`
 	WhitelistedAddresses []sdkTypes.AccAddress `json:"whitelisted_addresses"`
+	WhitelistedAccounts  []WhitelistedAccount  `json:"whitelisted_accounts"`
+	TierLimits           []TierLimit           `json:"tier_limits"`
+	KycBindings          []KycBinding          `json:"kyc_bindings"`
+}
+
+// WhitelistedAccount keeps the kyc address which the account was whitelisted with.
+type WhitelistedAccount struct {
+	Address           sdkTypes.AccAddress `json:"address"`
+	KycAddress        string              `json:"kyc_address"`
+	ExpiryHeight      int64               `json:"expiry_height,omitempty"`
+	ExpiryTime        int64               `json:"expiry_time,omitempty"`
+	Tier              string              `json:"tier,omitempty"`
+	WhitelistedHeight int64               `json:"whitelisted_height,omitempty"`
 }
 
 func DefaultGenesisState() GenesisState {
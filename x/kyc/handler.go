@@ -2,6 +2,7 @@ package kyc
 
 import (
 	"fmt"
+	"strconv"
 
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maxonrow/maxonrow-go/types"
@@ -14,6 +15,10 @@ func NewHandler(keeper *Keeper) sdkTypes.Handler {
 			return handleMsgWhitelist(ctx, keeper, msg)
 		case MsgRevokeWhitelist:
 			return handleMsgRevokeWhitelist(ctx, keeper, msg)
+		case MsgRenewWhitelist:
+			return handleMsgRenewWhitelist(ctx, keeper, msg)
+		case MsgUpgradeKycTier:
+			return handleMsgUpgradeKycTier(ctx, keeper, msg)
 		case MsgKycBind:
 			return handleMsgKycBind(ctx, keeper, msg)
 		case MsgKycUnbind:
@@ -37,7 +42,14 @@ func handleMsgWhitelist(ctx sdkTypes.Context, keeper *Keeper, msg MsgWhitelist)
 		return signaturesErr.Result()
 	}
 
+	expiry := msg.KycData.Payload.Kyc.GetExpiry()
+	if expiryErr := ValidateWhitelistExpiry(ctx, expiry); expiryErr != nil {
+		return expiryErr.Result()
+	}
+
 	keeper.Whitelist(ctx, msg.KycData.Payload.Kyc.From, msg.KycData.Payload.Kyc.KycAddress)
+	keeper.SetWhitelistExpiry(ctx, msg.KycData.Payload.Kyc.From, expiry)
+	keeper.SetKycTier(ctx, msg.KycData.Payload.Kyc.From, msg.KycData.Payload.Kyc.Tier)
 
 	ownerWalletAccount := keeper.accountKeeper.GetAccount(ctx, msg.Owner)
 	accountSequence := ownerWalletAccount.GetSequence()
@@ -67,12 +79,81 @@ func handleMsgRevokeWhitelist(ctx sdkTypes.Context, keeper *Keeper, msg MsgRevok
 
 }
 
+// handleMsgRenewWhitelist extends the expiry of a whitelisted account, the kyc address stays the same.
+func handleMsgRenewWhitelist(ctx sdkTypes.Context, keeper *Keeper, msg MsgRenewWhitelist) sdkTypes.Result {
+
+	if !keeper.IsAuthorised(ctx, msg.Owner) {
+		return sdkTypes.ErrUnauthorized("Not authorized to whitelist").Result()
+	}
+
+	target := msg.RenewPayload.To
+	if !keeper.IsWhitelisted(ctx, target) {
+		return sdkTypes.ErrUnknownRequest("Account is not whitelisted.").Result()
+	}
+
+	if string(keeper.GetKycAddress(ctx, target)) != msg.RenewPayload.KycAddress {
+		return sdkTypes.ErrUnknownRequest("Kyc address does not match.").Result()
+	}
+
+	expiry := msg.RenewPayload.GetExpiry()
+	if expiryErr := ValidateWhitelistExpiry(ctx, expiry); expiryErr != nil {
+		return expiryErr.Result()
+	}
+
+	signaturesErr := keeper.ValidateRenewWhitelistSignatures(ctx, msg)
+	if signaturesErr != nil {
+		return signaturesErr.Result()
+	}
+
+	keeper.SetWhitelistExpiry(ctx, target, expiry)
+
+	ownerWalletAccount := keeper.accountKeeper.GetAccount(ctx, msg.Owner)
+	accountSequence := ownerWalletAccount.GetSequence()
+	resultLog := types.NewResultLog(accountSequence, ctx.TxBytes())
+
+	eventParam := []string{target.String(), msg.RenewPayload.KycAddress, strconv.FormatInt(expiry.ExpiryHeight, 10), strconv.FormatInt(expiry.ExpiryTime, 10)}
+	eventSignature := "RenewedWhitelist(string,string,string,string)"
+
+	return sdkTypes.Result{
+		Events: types.MakeMxwEvents(eventSignature, msg.GetSigners()[0].String(), eventParam),
+		Log:    resultLog.String(),
+	}
+}
+
+// handleMsgUpgradeKycTier changes the tier of a whitelisted account, and so the transfer limits it has.
+func handleMsgUpgradeKycTier(ctx sdkTypes.Context, keeper *Keeper, msg MsgUpgradeKycTier) sdkTypes.Result {
+
+	if !keeper.IsAuthorised(ctx, msg.Owner) {
+		return sdkTypes.ErrUnauthorized("Not authorized to upgrade kyc tier").Result()
+	}
+
+	if !keeper.IsWhitelisted(ctx, msg.Target) {
+		return sdkTypes.ErrUnknownRequest("Account is not whitelisted.").Result()
+	}
+
+	keeper.SetKycTier(ctx, msg.Target, msg.Tier)
+
+	ownerWalletAccount := keeper.accountKeeper.GetAccount(ctx, msg.Owner)
+	accountSequence := ownerWalletAccount.GetSequence()
+	resultLog := types.NewResultLog(accountSequence, ctx.TxBytes())
+
+	eventParam := []string{msg.Target.String(), msg.Tier}
+	eventSignature := "UpgradedKycTier(string,string)"
+
+	return sdkTypes.Result{
+		Events: types.MakeMxwEvents(eventSignature, msg.GetSigners()[0].String(), eventParam),
+		Log:    resultLog.String(),
+	}
+}
+
 func handleMsgKycBind(ctx sdkTypes.Context, keeper *Keeper, msg MsgKycBind) sdkTypes.Result {
 
-	if !keeper.IsWhitelisted(ctx, msg.From) {
-		return sdkTypes.ErrUnauthorized("Singer is not whitelisted.").Result()
+	if err := keeper.ValidateKycBind(ctx, msg); err != nil {
+		return err.Result()
 	}
 
+	keeper.KycBind(ctx, msg.To, msg.KycAddress)
+
 	ownerWalletAccount := keeper.accountKeeper.GetAccount(ctx, msg.From)
 	accountSequence := ownerWalletAccount.GetSequence()
 	resultLog := types.NewResultLog(accountSequence, ctx.TxBytes())
@@ -88,10 +169,12 @@ func handleMsgKycBind(ctx sdkTypes.Context, keeper *Keeper, msg MsgKycBind) sdkT
 
 func handleMsgKycUnbind(ctx sdkTypes.Context, keeper *Keeper, msg MsgKycUnbind) sdkTypes.Result {
 
-	if !keeper.IsWhitelisted(ctx, msg.From) {
-		return sdkTypes.ErrUnauthorized("Singer is not whitelisted.").Result()
+	if err := keeper.ValidateKycUnbind(ctx, msg); err != nil {
+		return err.Result()
 	}
 
+	keeper.KycUnbind(ctx, msg.To)
+
 	ownerWalletAccount := keeper.accountKeeper.GetAccount(ctx, msg.From)
 	accountSequence := ownerWalletAccount.GetSequence()
 	resultLog := types.NewResultLog(accountSequence, ctx.TxBytes())
@@ -244,20 +244,13 @@ func (k Keeper) Whitelist(ctx sdkTypes.Context, targetAddress sdkTypes.AccAddres
 	kycDataKey := getKycDataKey([]byte(kycAddress))
 	whitelistStore.Set(whitelistedKey, []byte(kycAddress))
 	kycDataStore.Set(kycDataKey, targetAddress.Bytes())
+	k.setWhitelistedHeight(ctx, targetAddress, ctx.BlockHeight())
 
 }
 
 func (k Keeper) RevokeWhitelist(ctx sdkTypes.Context, targetAddress sdkTypes.AccAddress, owner sdkTypes.AccAddress) sdkTypes.Result {
 
-	kycDataByte := k.GetKycAddress(ctx, targetAddress)
-
-	whitelistStore := ctx.KVStore(k.whitelistedStoreKey)
-	kycDataStore := ctx.KVStore(k.kycDataStoreKey)
-	whitelistedKey := getWhitelistedKey(targetAddress)
-	kycDataKey := getKycDataKey(kycDataByte)
-
-	kycDataStore.Delete(kycDataKey)
-	whitelistStore.Delete(whitelistedKey)
+	kycDataByte := k.removeWhitelist(ctx, targetAddress)
 
 	ownerWalletAccount := k.accountKeeper.GetAccount(ctx, owner)
 	accountSequence := ownerWalletAccount.GetSequence()
@@ -271,6 +264,28 @@ func (k Keeper) RevokeWhitelist(ctx sdkTypes.Context, targetAddress sdkTypes.Acc
 		Log:    resultLog.String()}
 }
 
+// removeWhitelist removes the account from the whitelist with its kyc address and expiry, it returns the kyc address.
+func (k Keeper) removeWhitelist(ctx sdkTypes.Context, targetAddress sdkTypes.AccAddress) []byte {
+
+	kycDataByte := k.GetKycAddress(ctx, targetAddress)
+
+	whitelistStore := ctx.KVStore(k.whitelistedStoreKey)
+	kycDataStore := ctx.KVStore(k.kycDataStoreKey)
+	whitelistedKey := getWhitelistedKey(targetAddress)
+	kycDataKey := getKycDataKey(kycDataByte)
+
+	kycDataStore.Delete(kycDataKey)
+	whitelistStore.Delete(whitelistedKey)
+	k.deleteWhitelistExpiry(ctx, targetAddress)
+	k.SetKycTier(ctx, targetAddress, "")
+	k.deleteWhitelistedHeight(ctx, targetAddress)
+	if len(kycDataByte) != 0 {
+		k.unbindAll(ctx, string(kycDataByte))
+	}
+
+	return kycDataByte
+}
+
 func (k Keeper) IsKycAddressExist(ctx sdkTypes.Context, kycAddress string) bool {
 	kycDataStore := ctx.KVStore(k.kycDataStoreKey)
 	key := getKycDataKey([]byte(kycAddress))
@@ -292,7 +307,8 @@ func (k Keeper) GetKycAddress(ctx sdkTypes.Context, address sdkTypes.AccAddress)
 func (k Keeper) CheckTx(ctx sdkTypes.Context, tx sdkAuth.StdTx) bool {
 	allSigners := tx.GetSigners()
 	for _, signer := range allSigners {
-		if !k.IsWhitelisted(ctx, signer) {
+		// a wallet bound to the kyc address of a whitelisted account passes kyc too
+		if !k.IsWhitelisted(ctx, signer) && !k.IsKycBound(ctx, signer) {
 			return false
 		}
 	}
@@ -510,6 +526,48 @@ func (k Keeper) ValidateRevokeWhitelistSignatures(ctx sdkTypes.Context, msg sdkT
 	return nil
 }
 
+// ValidateRenewWhitelistSignatures verifies the issuer signatures of a renewal.
+// The nonce is the sequence of the first issuer, the issuer sequences are bumped so a renewal can't be replayed.
+func (k Keeper) ValidateRenewWhitelistSignatures(ctx sdkTypes.Context, msg MsgRenewWhitelist) sdkTypes.Error {
+
+	issuerSignBytes := msg.RenewPayload.GetRenewIssuerSignBytes()
+
+	nonce, nonceErr := strconv.ParseUint(msg.RenewPayload.Nonce, 10, 64)
+	if nonceErr != nil {
+		return sdkTypes.ErrInvalidSequence("Issuer signature is invalid.")
+	}
+
+	//* the nonce is the sequence of the one issuer who signs
+	if len(msg.Signatures) != 1 {
+		return sdkTypes.ErrUnauthorized("Renewal requires exactly one issuer signature.")
+	}
+
+	//* verify issuer sign
+	issuerAddr := sdkTypes.AccAddress(msg.Signatures[0].PubKey.Address())
+	if !k.IsIssuer(ctx, issuerAddr) {
+		return sdkTypes.ErrUnauthorized("Unauthorized signature.")
+	}
+
+	issuerAcc := k.accountKeeper.GetAccount(ctx, issuerAddr)
+	if issuerAcc == nil {
+		return sdkTypes.ErrUnknownAddress(issuerAddr.String())
+	}
+
+	if nonce != issuerAcc.GetSequence() {
+		return sdkTypes.ErrInvalidSequence("Issuer signature is invalid.")
+	}
+
+	if !(processSig(issuerAcc, msg.Signatures[0], issuerSignBytes)) {
+
+		return sdkTypes.ErrUnauthorized("Signature verification failed.")
+	}
+
+	issuerAcc.SetSequence(issuerAcc.GetSequence() + 1)
+	k.accountKeeper.SetAccount(ctx, issuerAcc)
+
+	return nil
+}
+
 // ProcessPubKey verifies that the given account address matches that of the
 // StdSignature. In addition, it will set the public key of the account if it
 // has not been smiet.
@@ -542,9 +600,7 @@ func ProcessPubKey(acc exported.Account, sig Signature) (crypto.PubKey, sdkTypes
 func (k *Keeper) ListAllWhitelistedAccounts(ctx sdkTypes.Context) []sdkTypes.AccAddress {
 
 	store := ctx.KVStore(k.whitelistedStoreKey)
-	start := append(prefixWhitelisted, 0x00)
-	end := append(prefixWhitelisted, 0xFF)
-	iter := store.Iterator(start, end)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixWhitelisted)
 	defer iter.Close()
 
 	var lst = make([]sdkTypes.AccAddress, 0)
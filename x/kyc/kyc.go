@@ -1,10 +1,27 @@
 package kyc
 
 import (
+	"fmt"
+
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 )
 
 func InitGenesis(ctx sdkTypes.Context, keeper *Keeper, genesisState GenesisState) {
+	// Restore the exported whitelist first, so the role addresses below keep their kyc address.
+	for _, whitelistedAccount := range genesisState.WhitelistedAccounts {
+		keeper.Whitelist(ctx, whitelistedAccount.Address, whitelistedAccount.KycAddress)
+		keeper.SetWhitelistExpiry(ctx, whitelistedAccount.Address, WhitelistExpiry{whitelistedAccount.ExpiryHeight, whitelistedAccount.ExpiryTime})
+		keeper.SetKycTier(ctx, whitelistedAccount.Address, whitelistedAccount.Tier)
+		keeper.setWhitelistedHeight(ctx, whitelistedAccount.Address, whitelistedAccount.WhitelistedHeight)
+	}
+
+	// The transfers within the rolling period are not exported, the limits start over.
+	for _, tierLimit := range genesisState.TierLimits {
+		if err := keeper.SetTierLimit(ctx, tierLimit); err != nil {
+			panic(err)
+		}
+	}
+
 	var validAuthorizedAddresses []sdkTypes.AccAddress
 	for _, AuthorizedAddressesString := range genesisState.AuthorizedAddresses {
 
@@ -14,7 +31,9 @@ func InitGenesis(ctx sdkTypes.Context, keeper *Keeper, genesisState GenesisState
 			panic("Invalid authorised address")
 		}
 
-		keeper.Whitelist(ctx, authorisedAddress, authorisedAddress.String())
+		if !keeper.IsWhitelisted(ctx, authorisedAddress) {
+			keeper.Whitelist(ctx, authorisedAddress, authorisedAddress.String())
+		}
 		validAuthorizedAddresses = append(validAuthorizedAddresses, authorisedAddress)
 	}
 	keeper.SetAuthorisedAddresses(ctx, validAuthorizedAddresses)
@@ -25,7 +44,9 @@ func InitGenesis(ctx sdkTypes.Context, keeper *Keeper, genesisState GenesisState
 			panic("Invalid whitelisted address")
 		}
 
-		keeper.Whitelist(ctx, address, address.String())
+		if !keeper.IsWhitelisted(ctx, address) {
+			keeper.Whitelist(ctx, address, address.String())
+		}
 	}
 
 	var validIssuerAddresses []sdkTypes.AccAddress
@@ -36,7 +57,9 @@ func InitGenesis(ctx sdkTypes.Context, keeper *Keeper, genesisState GenesisState
 			panic("Invalid issuer address")
 		}
 
-		keeper.Whitelist(ctx, issuerAdd, issuerAdd.String())
+		if !keeper.IsWhitelisted(ctx, issuerAdd) {
+			keeper.Whitelist(ctx, issuerAdd, issuerAdd.String())
+		}
 		validIssuerAddresses = append(validIssuerAddresses, issuerAdd)
 	}
 	keeper.SetIssuerAddresses(ctx, validIssuerAddresses)
@@ -49,12 +72,41 @@ func InitGenesis(ctx sdkTypes.Context, keeper *Keeper, genesisState GenesisState
 			panic("Invalid provider address")
 		}
 
-		keeper.Whitelist(ctx, providerAdd, providerAdd.String())
+		if !keeper.IsWhitelisted(ctx, providerAdd) {
+			keeper.Whitelist(ctx, providerAdd, providerAdd.String())
+		}
 		validProviderAddresses = append(validProviderAddresses, providerAdd)
 	}
 	keeper.SetProviderAddresses(ctx, validProviderAddresses)
+
+	for _, binding := range genesisState.KycBindings {
+		if !keeper.IsKycAddressExist(ctx, binding.KycAddress) {
+			panic(fmt.Sprintf("Kyc address of bound wallet %s does not exist: %s", binding.Address, binding.KycAddress))
+		}
+		keeper.KycBind(ctx, binding.Address, binding.KycAddress)
+	}
 }
 
-func ExportGenesis(keeper *Keeper) GenesisState {
-	return GenesisState{}
+func ExportGenesis(ctx sdkTypes.Context, keeper *Keeper) GenesisState {
+	var whitelistedAccounts []WhitelistedAccount
+	for _, address := range keeper.ListAllWhitelistedAccounts(ctx) {
+		expiry := keeper.GetWhitelistExpiry(ctx, address)
+		whitelistedAccounts = append(whitelistedAccounts, WhitelistedAccount{
+			Address:           address,
+			KycAddress:        string(keeper.GetKycAddress(ctx, address)),
+			ExpiryHeight:      expiry.ExpiryHeight,
+			ExpiryTime:        expiry.ExpiryTime,
+			Tier:              keeper.GetKycTier(ctx, address),
+			WhitelistedHeight: keeper.GetWhitelistedHeight(ctx, address),
+		})
+	}
+
+	return GenesisState{
+		AuthorizedAddresses: keeper.GetAuthorisedAddresses(ctx),
+		IssuerAddresses:     keeper.GetIssuerAddresses(ctx),
+		ProviderAddresses:   keeper.GetProviderAddresses(ctx),
+		WhitelistedAccounts: whitelistedAccounts,
+		TierLimits:          keeper.ListTierLimits(ctx),
+		KycBindings:         keeper.ListAllKycBindings(ctx),
+	}
 }
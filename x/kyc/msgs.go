@@ -24,9 +24,12 @@ type Payload struct {
 }
 
 type Kyc struct {
-	From       sdkTypes.AccAddress `json:"from"`
-	Nonce      string              `json:"nonce"`
-	KycAddress string              `json:"kycAddress"` /// It's a reference to the kyc data
+	From         sdkTypes.AccAddress `json:"from"`
+	Nonce        string              `json:"nonce"`
+	KycAddress   string              `json:"kycAddress"`             /// It's a reference to the kyc data
+	ExpiryHeight int64               `json:"expiryHeight,omitempty"` /// Optional, the block height the kyc expires at
+	ExpiryTime   int64               `json:"expiryTime,omitempty"`   /// Optional, the block time in unix seconds the kyc expires at
+	Tier         string              `json:"tier,omitempty"`         /// Optional, the tier of the transfer limits
 }
 
 type Signature struct {
@@ -97,9 +100,20 @@ func (msg MsgWhitelist) ValidateBasic() sdkTypes.Error {
 		return sdkTypes.ErrInvalidAddress(msg.KycData.Payload.Kyc.KycAddress)
 	}
 
+	if msg.KycData.Payload.Kyc.ExpiryHeight < 0 || msg.KycData.Payload.Kyc.ExpiryTime < 0 {
+		return sdkTypes.ErrUnknownRequest("Expiry cannot be negative.")
+	}
+
 	return nil
 }
 
+func (kyc Kyc) GetExpiry() WhitelistExpiry {
+	return WhitelistExpiry{
+		ExpiryHeight: kyc.ExpiryHeight,
+		ExpiryTime:   kyc.ExpiryTime,
+	}
+}
+
 func (payload Payload) GetIssuerSignBytes() []byte {
 	b, err := msgCdc.MarshalJSON(payload)
 	if err != nil {
@@ -223,17 +237,165 @@ func (msg MsgRevokeWhitelist) GetSigners() []sdkTypes.AccAddress {
 	return []sdkTypes.AccAddress{msg.Owner}
 }
 
+type MsgRenewWhitelist struct {
+	Owner        sdkTypes.AccAddress `json:"owner"`
+	RenewPayload RenewPayload        `json:"payload"`
+	Signatures   []Signature         `json:"signatures"`
+}
+
+// RenewPayload is signed by an issuer, the kyc address makes sure it only renews the whitelisting it was signed for.
+// The nonce is the account sequence of the issuer, so a renewal can only be used once.
+type RenewPayload struct {
+	To           sdkTypes.AccAddress `json:"to"`
+	Nonce        string              `json:"nonce"`
+	KycAddress   string              `json:"kycAddress"`
+	ExpiryHeight int64               `json:"expiryHeight,omitempty"`
+	ExpiryTime   int64               `json:"expiryTime,omitempty"`
+}
+
+func NewMsgRenewWhitelist(owner sdkTypes.AccAddress, renewPayload RenewPayload, signatures []Signature) MsgRenewWhitelist {
+	return MsgRenewWhitelist{
+		Owner:        owner,
+		RenewPayload: renewPayload,
+		Signatures:   signatures,
+	}
+}
+
+func NewRenewPayload(to sdkTypes.AccAddress, nonce string, kycAddress string, expiryHeight, expiryTime int64) RenewPayload {
+	return RenewPayload{
+		To:           to,
+		Nonce:        nonce,
+		KycAddress:   kycAddress,
+		ExpiryHeight: expiryHeight,
+		ExpiryTime:   expiryTime,
+	}
+}
+
+func (msg MsgRenewWhitelist) Route() string {
+	return "kyc"
+}
+
+func (msg MsgRenewWhitelist) Type() string {
+	return "renewWhitelist"
+}
+
+func (msg MsgRenewWhitelist) ValidateBasic() sdkTypes.Error {
+
+	if msg.Owner.Empty() {
+		return sdkTypes.ErrInvalidAddress(msg.Owner.String())
+	}
+
+	if len(msg.Signatures) != 1 {
+		return sdkTypes.ErrUnauthorized("Renewal requires exactly one issuer signature.")
+	}
+
+	if msg.RenewPayload.To.Empty() {
+		return sdkTypes.ErrInvalidAddress("To cannot be empty.")
+	}
+
+	if len(msg.RenewPayload.KycAddress) <= 0 {
+		return sdkTypes.ErrInvalidAddress(msg.RenewPayload.KycAddress)
+	}
+
+	expiry := msg.RenewPayload.GetExpiry()
+	if expiry.ExpiryHeight < 0 || expiry.ExpiryTime < 0 {
+		return sdkTypes.ErrUnknownRequest("Expiry cannot be negative.")
+	}
+
+	if expiry.IsEmpty() {
+		return sdkTypes.ErrUnknownRequest("Expiry height or time is required.")
+	}
+
+	return nil
+}
+
+func (renewPayload RenewPayload) GetExpiry() WhitelistExpiry {
+	return WhitelistExpiry{
+		ExpiryHeight: renewPayload.ExpiryHeight,
+		ExpiryTime:   renewPayload.ExpiryTime,
+	}
+}
+
+func (renewPayload RenewPayload) GetRenewIssuerSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(renewPayload)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdkTypes.MustSortJSON(b)
+}
+
+func (msg MsgRenewWhitelist) GetSignBytes() []byte {
+
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners get signers
+func (msg MsgRenewWhitelist) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Owner}
+}
+
+// MsgUpgradeKycTier changes the tier of a whitelisted account, an empty tier removes its transfer limits.
+type MsgUpgradeKycTier struct {
+	Owner  sdkTypes.AccAddress `json:"owner"`
+	Target sdkTypes.AccAddress `json:"target"`
+	Tier   string              `json:"tier"`
+}
+
+func NewMsgUpgradeKycTier(owner, target sdkTypes.AccAddress, tier string) MsgUpgradeKycTier {
+	return MsgUpgradeKycTier{
+		Owner:  owner,
+		Target: target,
+		Tier:   tier,
+	}
+}
+
+func (msg MsgUpgradeKycTier) Route() string {
+	return "kyc"
+}
+
+func (msg MsgUpgradeKycTier) Type() string {
+	return "upgradeKycTier"
+}
+
+func (msg MsgUpgradeKycTier) ValidateBasic() sdkTypes.Error {
+
+	if msg.Owner.Empty() {
+		return sdkTypes.ErrInvalidAddress(msg.Owner.String())
+	}
+
+	if msg.Target.Empty() {
+		return sdkTypes.ErrInvalidAddress("Target cannot be empty.")
+	}
+
+	return nil
+}
+
+func (msg MsgUpgradeKycTier) GetSignBytes() []byte {
+
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners get signers
+func (msg MsgUpgradeKycTier) GetSigners() []sdkTypes.AccAddress {
+	return []sdkTypes.AccAddress{msg.Owner}
+}
+
+// MsgKycBind binds the wallet To to the kyc address of From, To agrees to it by signing the bind.
 type MsgKycBind struct {
 	From       sdkTypes.AccAddress `json:"from"`
 	To         sdkTypes.AccAddress `json:"to"`
 	KycAddress string              `json:"kycAddress"`
+	Nonce      string              `json:"nonce"`     /// The sequence of To
+	Signature  Signature           `json:"signature"` /// The signature of To, of the bind without the signature
 }
 
-func NewMsgKycBind(from, to sdkTypes.AccAddress, kycAddress string) MsgKycBind {
+func NewMsgKycBind(from, to sdkTypes.AccAddress, kycAddress string, nonce string) MsgKycBind {
 	return MsgKycBind{
 		From:       from,
 		To:         to,
 		KycAddress: kycAddress,
+		Nonce:      nonce,
 	}
 }
 
@@ -255,18 +417,33 @@ func (msg MsgKycBind) ValidateBasic() sdkTypes.Error {
 		return sdkTypes.ErrInvalidAddress(msg.To.String())
 	}
 
+	if msg.From.Equals(msg.To) {
+		return sdkTypes.ErrInvalidAddress("Cannot bind the signer to itself.")
+	}
+
 	if len(msg.KycAddress) < 1 {
 		return sdkTypes.ErrInvalidAddress(msg.KycAddress)
 	}
+
+	if msg.Signature.PubKey == nil || !msg.To.Equals(sdkTypes.AccAddress(msg.Signature.PubKey.Address())) {
+		return sdkTypes.ErrUnauthorized("Wallet signature is required.")
+	}
 	return nil
 }
 
+// GetToSignBytes returns the bytes To signs, the bind without the signature.
+func (msg MsgKycBind) GetToSignBytes() []byte {
+	msg.Signature = Signature{}
+
+	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
 func (msg MsgKycBind) GetSignBytes() []byte {
 
 	return sdkTypes.MustSortJSON(msgCdc.MustMarshalJSON(msg))
 }
 
-// GetSigners get signers
+// GetSigners get signers, To signs the bind in the msg as it can't pass kyc before it is bound.
 func (msg MsgKycBind) GetSigners() []sdkTypes.AccAddress {
 	return []sdkTypes.AccAddress{msg.From}
 }
@@ -1,6 +1,10 @@
 package kyc
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -8,10 +12,20 @@ import (
 )
 
 const (
-	QueryIsWhitelisted = "is_whitelisted"
-	QueryIsAuthorised  = "is_authorised"
-	QueryGetKycAddress = "get_kyc_address"
-	QueryGetFee        = "get_fee"
+	QueryIsWhitelisted         = "is_whitelisted"
+	QueryIsAuthorised          = "is_authorised"
+	QueryGetKycAddress         = "get_kyc_address"
+	QueryGetFee                = "get_fee"
+	QueryGetWhitelistExpiry    = "get_whitelist_expiry"
+	QueryGetKycTier            = "get_kyc_tier"
+	QueryListTierLimits        = "list_tier_limits"
+	QueryGetKycBinding         = "get_kyc_binding"
+	QueryGetKycBindings        = "get_kyc_bindings"
+	QueryListWhitelisted       = "list_whitelisted"
+	QueryGetWhitelistedAddress = "get_whitelisted_address"
+	QueryListAuthorised        = "list_authorised"
+	QueryListIssuers           = "list_issuers"
+	QueryListProviders         = "list_providers"
 )
 
 func NewQuerier(keeper *Keeper, feeKeeper *fee.Keeper) sdkTypes.Querier {
@@ -25,6 +39,26 @@ func NewQuerier(keeper *Keeper, feeKeeper *fee.Keeper) sdkTypes.Querier {
 			return queryGetKycAddress(ctx, path[1:], req, keeper)
 		case QueryGetFee:
 			return queryGetFee(ctx, path[1:], req, keeper, feeKeeper)
+		case QueryGetWhitelistExpiry:
+			return queryGetWhitelistExpiry(ctx, path[1:], req, keeper)
+		case QueryGetKycTier:
+			return queryGetKycTier(ctx, path[1:], req, keeper)
+		case QueryListTierLimits:
+			return queryListTierLimits(ctx, path[1:], req, keeper)
+		case QueryGetKycBinding:
+			return queryGetKycBinding(ctx, path[1:], req, keeper)
+		case QueryGetKycBindings:
+			return queryGetKycBindings(ctx, path[1:], req, keeper)
+		case QueryListWhitelisted:
+			return queryListWhitelisted(ctx, path[1:], req, keeper)
+		case QueryGetWhitelistedAddress:
+			return queryGetWhitelistedAddress(ctx, path[1:], req, keeper)
+		case QueryListAuthorised:
+			return codec.MustMarshalJSONIndent(keeper.cdc, keeper.GetAuthorisedAddresses(ctx)), nil
+		case QueryListIssuers:
+			return codec.MustMarshalJSONIndent(keeper.cdc, keeper.GetIssuerAddresses(ctx)), nil
+		case QueryListProviders:
+			return codec.MustMarshalJSONIndent(keeper.cdc, keeper.GetProviderAddresses(ctx)), nil
 		default:
 			return nil, sdkTypes.ErrUnknownRequest("unknown kyc query endpoint")
 		}
@@ -78,25 +112,158 @@ func queryGetKycAddress(ctx sdkTypes.Context, path []string, req abci.RequestQue
 
 }
 
+func queryGetWhitelistExpiry(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+
+	if len(path) != 1 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	address, err := sdkTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrInvalidAddress(path[0])
+	}
+
+	if !keeper.IsWhitelisted(ctx, address) {
+		return nil, sdkTypes.ErrUnknownRequest("Account is not whitelisted.")
+	}
+
+	respData := codec.MustMarshalJSONIndent(keeper.cdc, keeper.GetWhitelistExpiry(ctx, address))
+
+	return respData, nil
+}
+
+func queryGetKycTier(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+
+	if len(path) != 1 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	address, err := sdkTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrInvalidAddress(path[0])
+	}
+
+	if !keeper.IsWhitelisted(ctx, address) && !keeper.IsKycBound(ctx, address) {
+		return nil, sdkTypes.ErrUnknownRequest("Account is not whitelisted.")
+	}
+
+	respData := codec.MustMarshalJSONIndent(keeper.cdc, keeper.GetKycTierInfo(ctx, address))
+
+	return respData, nil
+}
+
+func queryListTierLimits(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+
+	respData := codec.MustMarshalJSONIndent(keeper.cdc, keeper.ListTierLimits(ctx))
+
+	return respData, nil
+}
+
+func queryGetKycBinding(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+
+	if len(path) != 1 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	address, err := sdkTypes.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkTypes.ErrInvalidAddress(path[0])
+	}
+
+	kycAddress := keeper.GetKycBinding(ctx, address)
+	if len(kycAddress) == 0 {
+		return nil, sdkTypes.ErrUnknownRequest("Wallet is not bound to a kyc address.")
+	}
+
+	return []byte(kycAddress), nil
+}
+
+func queryGetKycBindings(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+
+	if len(path) != 1 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	if !keeper.IsKycAddressExist(ctx, path[0]) {
+		return nil, sdkTypes.ErrUnknownRequest("Kyc address does not exist.")
+	}
+
+	addresses := keeper.GetKycBindings(ctx, path[0])
+	if addresses == nil {
+		addresses = []sdkTypes.AccAddress{}
+	}
+
+	respData := codec.MustMarshalJSONIndent(keeper.cdc, addresses)
+
+	return respData, nil
+}
+
+// queryListWhitelisted returns a page of the whitelisted accounts, the path is [page] [limit], both optional.
+func queryListWhitelisted(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+
+	if len(path) > 2 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	page, limit := 1, DefaultQueryLimit
+	if len(path) > 0 {
+		var err error
+		page, err = strconv.Atoi(path[0])
+		if err != nil || page < 1 {
+			return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid page %s", path[0]))
+		}
+	}
+	if len(path) > 1 {
+		var err error
+		limit, err = strconv.Atoi(path[1])
+		if err != nil || limit < 1 || limit > MaxQueryLimit {
+			return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid limit %s, it is from 1 to %d", path[1], MaxQueryLimit))
+		}
+	}
+
+	respData := codec.MustMarshalJSONIndent(keeper.cdc, keeper.ListWhitelistedAccounts(ctx, page, limit))
+
+	return respData, nil
+}
+
+func queryGetWhitelistedAddress(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper *Keeper) ([]byte, sdkTypes.Error) {
+
+	if len(path) != 1 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	address := keeper.GetWhitelistedAddress(ctx, path[0])
+	if address == nil {
+		return nil, sdkTypes.ErrUnknownRequest("Kyc address does not exist.")
+	}
+
+	return []byte(address.String()), nil
+}
+
 func queryGetFee(ctx sdkTypes.Context, path []string, req abci.RequestQuery, keeper *Keeper, feeKeeper *fee.Keeper) ([]byte, sdkTypes.Error) {
 
-	msgType := path[0]
-	totalAmount, parseCoinsErr := sdkTypes.ParseCoins("")
-	if parseCoinsErr != nil {
-		return nil, sdkTypes.ErrInvalidCoins("Invalid amount")
+	if len(path) != 1 && len(path) != 2 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
 	}
 
-	feeSetting, feeSettingErr := feeKeeper.GetMsgFeeSetting(ctx, msgType)
-	if feeSettingErr != nil {
-		return nil, feeSettingErr
+	msgType := path[0]
+
+	// optional signer, the account fee setting of the signer overrides the msg fee setting
+	var signer sdkTypes.AccAddress
+	if len(path) == 2 {
+		var err error
+		signer, err = sdkTypes.AccAddressFromBech32(path[1])
+		if err != nil {
+			return nil, sdkTypes.ErrInvalidAddress(path[1])
+		}
 	}
 
-	calculatedFee, calculatedFeeErr := fee.DefaultCalculateFee(ctx, feeSetting, totalAmount)
-	if calculatedFeeErr != nil {
-		return nil, calculatedFeeErr
+	quote, quoteErr := feeKeeper.QuoteFee(ctx, fee.NewFeeRequest(msgType, signer, nil))
+	if quoteErr != nil {
+		return nil, quoteErr
 	}
 
-	respData := sdkTypes.MustSortJSON(codec.Cdc.MustMarshalJSON(calculatedFee))
+	respData := sdkTypes.MustSortJSON(codec.Cdc.MustMarshalJSON(quote.FeeAmount()))
 
 	return respData, nil
 
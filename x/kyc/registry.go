@@ -0,0 +1,102 @@
+package kyc
+
+import (
+	"encoding/binary"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
+)
+
+var prefixWhitelistedHeight = []byte("0x0e")
+
+func getWhitelistedHeightKey(addr sdkTypes.AccAddress) []byte {
+	return append(append([]byte{}, prefixWhitelistedHeight...), addr.Bytes()...)
+}
+
+// WhitelistedAccountInfo is a whitelisted account with the kyc address and the block height it was whitelisted at.
+type WhitelistedAccountInfo struct {
+	Address           sdkTypes.AccAddress `json:"address"`
+	KycAddress        string              `json:"kyc_address"`
+	WhitelistedHeight int64               `json:"whitelisted_height"`
+}
+
+// WhitelistedAccountsPage is a page of the whitelisted accounts, pages start at 1.
+type WhitelistedAccountsPage struct {
+	Total    int                      `json:"total"`
+	Page     int                      `json:"page"`
+	Limit    int                      `json:"limit"`
+	Accounts []WhitelistedAccountInfo `json:"accounts"`
+}
+
+func (k Keeper) setWhitelistedHeight(ctx sdkTypes.Context, addr sdkTypes.AccAddress, height int64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	store.Set(getWhitelistedHeightKey(addr), bz)
+}
+
+// GetWhitelistedHeight returns the block height the account was whitelisted at, 0 for the accounts of the genesis.
+func (k Keeper) GetWhitelistedHeight(ctx sdkTypes.Context, addr sdkTypes.AccAddress) int64 {
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	bz := store.Get(getWhitelistedHeightKey(addr))
+	if bz == nil {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func (k Keeper) deleteWhitelistedHeight(ctx sdkTypes.Context, addr sdkTypes.AccAddress) {
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	store.Delete(getWhitelistedHeightKey(addr))
+}
+
+// GetWhitelistedAddress returns the account whitelisted with the kyc address, nil if there is none.
+func (k Keeper) GetWhitelistedAddress(ctx sdkTypes.Context, kycAddress string) sdkTypes.AccAddress {
+	kycDataStore := ctx.KVStore(k.kycDataStoreKey)
+	bz := kycDataStore.Get(getKycDataKey([]byte(kycAddress)))
+	if bz == nil {
+		return nil
+	}
+
+	return sdkTypes.AccAddress(bz)
+}
+
+// ListWhitelistedAccounts returns a page of the whitelisted accounts in address order, only the accounts of the page are read.
+func (k *Keeper) ListWhitelistedAccounts(ctx sdkTypes.Context, page, limit int) WhitelistedAccountsPage {
+	result := WhitelistedAccountsPage{
+		Total:    k.NumOfWhitelisted(ctx),
+		Page:     page,
+		Limit:    limit,
+		Accounts: []WhitelistedAccountInfo{},
+	}
+
+	// a page after the last one is empty, returning early keeps (page-1)*limit from overflowing
+	if limit < 1 || page-1 > result.Total/limit {
+		return result
+	}
+
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixWhitelisted)
+	defer iter.Close()
+
+	for skipped := 0; iter.Valid() && skipped < (page-1)*limit; iter.Next() {
+		skipped++
+	}
+
+	for ; iter.Valid() && len(result.Accounts) < limit; iter.Next() {
+		addr := sdkTypes.AccAddress(iter.Key()[len(prefixWhitelisted):])
+		result.Accounts = append(result.Accounts, WhitelistedAccountInfo{
+			Address:           addr,
+			KycAddress:        string(iter.Value()),
+			WhitelistedHeight: k.GetWhitelistedHeight(ctx, addr),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,258 @@
+package kyc
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maxonrow/maxonrow-go/types"
+)
+
+// TransferLimitPeriod is the rolling period of the transfer limits, in seconds of block time.
+const TransferLimitPeriod int64 = 24 * 60 * 60
+
+var prefixKycTier = []byte("0x09")
+var prefixTierLimit = []byte("0x0a")
+var prefixTransferred = []byte("0x0b")
+
+func getKycTierKey(addr sdkTypes.AccAddress) []byte {
+	return append(append([]byte{}, prefixKycTier...), addr.Bytes()...)
+}
+
+func getTierLimitKey(tier string) []byte {
+	return append(append([]byte{}, prefixTierLimit...), []byte(tier)...)
+}
+
+// a kyc address can't contain ':', so it ends the kyc address in the key.
+func getTransferredKey(kycAddress string, denom string) []byte {
+	return append(append(append(append([]byte{}, prefixTransferred...), []byte(kycAddress)...), ':'), []byte(denom)...)
+}
+
+// TransferLimit is the amount of cin, or of a fungible token by its symbol, an account can transfer in the rolling period.
+type TransferLimit struct {
+	Denom  string        `json:"denom"`
+	Amount sdkTypes.Uint `json:"amount"`
+}
+
+// TierLimit is the transfer limits of the accounts of a kyc tier, a tier or a denom without limit is unlimited.
+type TierLimit struct {
+	Tier   string          `json:"tier"`
+	Limits []TransferLimit `json:"limits"`
+}
+
+func (tierLimit TierLimit) ValidateBasic() sdkTypes.Error {
+	if len(tierLimit.Tier) == 0 {
+		return sdkTypes.ErrUnknownRequest("Tier cannot be empty.")
+	}
+
+	denoms := make(map[string]bool)
+	for _, limit := range tierLimit.Limits {
+		if len(limit.Denom) == 0 {
+			return sdkTypes.ErrUnknownRequest("Denom of the limit cannot be empty.")
+		}
+		if denoms[limit.Denom] {
+			return sdkTypes.ErrUnknownRequest(fmt.Sprintf("Duplicated limit of %s.", limit.Denom))
+		}
+		denoms[limit.Denom] = true
+	}
+
+	return nil
+}
+
+// GetLimit returns the limit of the denom, false if it is unlimited.
+func (tierLimit TierLimit) GetLimit(denom string) (sdkTypes.Uint, bool) {
+	for _, limit := range tierLimit.Limits {
+		if limit.Denom == denom {
+			return limit.Amount, true
+		}
+	}
+
+	return sdkTypes.ZeroUint(), false
+}
+
+// KycTierInfo is the tier of a kyc address with its limits and what its wallets transferred in the rolling period.
+type KycTierInfo struct {
+	Tier        string          `json:"tier"`
+	Limits      []TransferLimit `json:"limits"`
+	Transferred []TransferLimit `json:"transferred"`
+}
+
+type transferRecord struct {
+	Time   int64         `json:"time"`
+	Amount sdkTypes.Uint `json:"amount"`
+}
+
+// SetKycTier sets the tier of the whitelisted account, an empty tier removes it.
+func (k Keeper) SetKycTier(ctx sdkTypes.Context, addr sdkTypes.AccAddress, tier string) {
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	if len(tier) == 0 {
+		store.Delete(getKycTierKey(addr))
+		return
+	}
+
+	store.Set(getKycTierKey(addr), []byte(tier))
+}
+
+// GetKycTier returns the tier of the whitelisted account, empty if it has none.
+func (k Keeper) GetKycTier(ctx sdkTypes.Context, addr sdkTypes.AccAddress) string {
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	return string(store.Get(getKycTierKey(addr)))
+}
+
+// SetTierLimit replaces the limits of the tier, a tier limit without limits is removed.
+func (k Keeper) SetTierLimit(ctx sdkTypes.Context, tierLimit TierLimit) sdkTypes.Error {
+	if err := tierLimit.ValidateBasic(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	if len(tierLimit.Limits) == 0 {
+		store.Delete(getTierLimitKey(tierLimit.Tier))
+		return nil
+	}
+
+	store.Set(getTierLimitKey(tierLimit.Tier), k.cdc.MustMarshalBinaryLengthPrefixed(tierLimit))
+	return nil
+}
+
+func (k Keeper) GetTierLimit(ctx sdkTypes.Context, tier string) (TierLimit, bool) {
+	var tierLimit TierLimit
+
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	bz := store.Get(getTierLimitKey(tier))
+	if bz == nil {
+		return tierLimit, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &tierLimit)
+	return tierLimit, true
+}
+
+func (k Keeper) ListTierLimits(ctx sdkTypes.Context) []TierLimit {
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefixTierLimit)
+	defer iter.Close()
+
+	var tierLimits []TierLimit
+	for ; iter.Valid(); iter.Next() {
+		var tierLimit TierLimit
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &tierLimit)
+		tierLimits = append(tierLimits, tierLimit)
+	}
+
+	return tierLimits
+}
+
+// GetKycTierInfo returns the tier info of the kyc address of the account, which is whitelisted or bound to it.
+func (k Keeper) GetKycTierInfo(ctx sdkTypes.Context, addr sdkTypes.AccAddress) KycTierInfo {
+	whitelistedAddr, kycAddress := k.getKycIdentity(ctx, addr)
+	info := KycTierInfo{Tier: k.GetKycTier(ctx, whitelistedAddr)}
+
+	tierLimit, ok := k.GetTierLimit(ctx, info.Tier)
+	if !ok {
+		return info
+	}
+
+	info.Limits = tierLimit.Limits
+	for _, limit := range tierLimit.Limits {
+		info.Transferred = append(info.Transferred, TransferLimit{
+			Denom:  limit.Denom,
+			Amount: sumTransferRecords(k.getTransferRecords(ctx, kycAddress, limit.Denom)),
+		})
+	}
+
+	return info
+}
+
+// CheckTransferLimit returns an error if adding the amount to what the wallets of the kyc address of the account transferred
+// in the rolling period would exceed the limit of the tier of the whitelisted account of the kyc address, it doesn't record it.
+func (k Keeper) CheckTransferLimit(ctx sdkTypes.Context, addr sdkTypes.AccAddress, denom string, amount sdkTypes.Uint) sdkTypes.Error {
+	_, _, err := k.checkTransferLimit(ctx, addr, denom, amount)
+	return err
+}
+
+// ConsumeTransferLimit adds the amount to what the wallets of the kyc address of the account transferred in the rolling period,
+// it fails if that exceeds the limit of the tier of the whitelisted account of the kyc address.
+func (k Keeper) ConsumeTransferLimit(ctx sdkTypes.Context, addr sdkTypes.AccAddress, denom string, amount sdkTypes.Uint) sdkTypes.Error {
+	kycAddress, records, err := k.checkTransferLimit(ctx, addr, denom, amount)
+	if err != nil {
+		return err
+	}
+	if len(kycAddress) == 0 {
+		return nil
+	}
+
+	records = append(records, transferRecord{ctx.BlockHeader().Time.Unix(), amount})
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	store.Set(getTransferredKey(kycAddress, denom), k.cdc.MustMarshalBinaryLengthPrefixed(records))
+
+	return nil
+}
+
+// checkTransferLimit returns the kyc address of the account and its transfer records in the rolling period if the amount
+// is within the limit of its tier. The kyc address is empty if the tier has no limit for the denom.
+func (k Keeper) checkTransferLimit(ctx sdkTypes.Context, addr sdkTypes.AccAddress, denom string, amount sdkTypes.Uint) (string, []transferRecord, sdkTypes.Error) {
+	whitelistedAddr, kycAddress := k.getKycIdentity(ctx, addr)
+	tierLimit, ok := k.GetTierLimit(ctx, k.GetKycTier(ctx, whitelistedAddr))
+	if !ok {
+		return "", nil, nil
+	}
+
+	limit, ok := tierLimit.GetLimit(denom)
+	if !ok {
+		return "", nil, nil
+	}
+
+	records := k.getTransferRecords(ctx, kycAddress, denom)
+	transferred := sumTransferRecords(records).Add(amount)
+	if transferred.GT(limit) {
+		return "", nil, types.ErrKycTransferLimitExceeded(addr.String(), tierLimit.Tier, limit.String()+denom)
+	}
+
+	return kycAddress, records, nil
+}
+
+// getKycIdentity returns the whitelisted account and the kyc address of the account, a bound wallet resolves to the
+// whitelisted account of the kyc address it is bound to. Both are empty if the account has no kyc address.
+func (k Keeper) getKycIdentity(ctx sdkTypes.Context, addr sdkTypes.AccAddress) (sdkTypes.AccAddress, string) {
+	if kycAddress := k.GetKycBinding(ctx, addr); len(kycAddress) > 0 {
+		return k.GetWhitelistedAddress(ctx, kycAddress), kycAddress
+	}
+
+	kycAddress := string(k.GetKycAddress(ctx, addr))
+	if len(kycAddress) == 0 {
+		return nil, ""
+	}
+
+	return addr, kycAddress
+}
+
+// getTransferRecords returns the transfers of the wallets of the kyc address in the rolling period.
+func (k Keeper) getTransferRecords(ctx sdkTypes.Context, kycAddress string, denom string) []transferRecord {
+	store := ctx.KVStore(k.whitelistedStoreKey)
+	bz := store.Get(getTransferredKey(kycAddress, denom))
+	if bz == nil {
+		return nil
+	}
+
+	var records []transferRecord
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &records)
+
+	since := ctx.BlockHeader().Time.Unix() - TransferLimitPeriod
+	var inPeriod []transferRecord
+	for _, record := range records {
+		if record.Time > since {
+			inPeriod = append(inPeriod, record)
+		}
+	}
+
+	return inPeriod
+}
+
+func sumTransferRecords(records []transferRecord) sdkTypes.Uint {
+	total := sdkTypes.ZeroUint()
+	for _, record := range records {
+		total = total.Add(record.Amount)
+	}
+
+	return total
+}
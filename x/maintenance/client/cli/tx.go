@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -40,6 +41,13 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 			feeCollectorAddrStr := viper.GetString("fee-collector")
 			feeCollectorModule := viper.GetString("fee-collector-module")
 			action := viper.GetString("action")
+			validatorsShare := viper.GetString("validators-share")
+			treasuryShare := viper.GetString("treasury-share")
+			treasuryAddrStr := viper.GetString("treasury-address")
+			burnShare := viper.GetString("burn-share")
+			collectorShares := viper.GetStringSlice("collector-share")
+			tier := viper.GetString("tier")
+			tierLimits := viper.GetStringSlice("tier-limit")
 
 			proposalKind, proposalKindErr := maintenance.ProposalTypeFromString(proposalType)
 			if proposalKindErr != nil {
@@ -176,6 +184,53 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 				}
 				whitelistValidator := maintenance.NewWhitelistValidator(action, []crypto.PubKey{pubKeyAddr})
 				msg = maintenance.NewMsgSubmitProposal(title, description, proposalKind, &whitelistValidator, proposer)
+			case maintenance.ProposalTypeModifyFeeDistribution:
+
+				var treasuryAddr sdkTypes.AccAddress
+				if treasuryAddrStr != "" {
+					addr, addrErr := sdkTypes.AccAddressFromBech32(treasuryAddrStr)
+					if addrErr != nil {
+						return addrErr
+					}
+					treasuryAddr = addr
+				}
+
+				// collector shares are in module:percentage format
+				var collectors []maintenance.FeeCollectorShare
+				for _, collectorShare := range collectorShares {
+					parts := strings.Split(collectorShare, ":")
+					if len(parts) != 2 {
+						return fmt.Errorf("Invalid collector share %s, expected module:percentage", collectorShare)
+					}
+					collectors = append(collectors, maintenance.FeeCollectorShare{
+						Module:     parts[0],
+						Percentage: parts[1],
+					})
+				}
+
+				feeDistributionMaintainer := maintenance.NewFeeDistributionMaintainer(validatorsShare, treasuryShare, treasuryAddr, burnShare, collectors)
+				msg = maintenance.NewMsgSubmitProposal(title, description, proposalKind, &feeDistributionMaintainer, proposer)
+			case maintenance.ProposalTypeModifyKycTierLimit:
+
+				if tier == "" {
+					return sdkTypes.ErrInternal(fmt.Sprintf("Proposal type error, please check: %s, --proposalType %s", proposalKind.String(), proposalType))
+				}
+
+				// tier limits are in denom:amount format
+				var limits []maintenance.KycTierTransferLimit
+				for _, tierLimit := range tierLimits {
+					parts := strings.Split(tierLimit, ":")
+					if len(parts) != 2 {
+						return fmt.Errorf("Invalid tier limit %s, expected denom:amount", tierLimit)
+					}
+					limits = append(limits, maintenance.KycTierTransferLimit{
+						Denom:  parts[0],
+						Amount: parts[1],
+					})
+				}
+
+				kycTierLimitMaintainer := maintenance.NewKycTierLimitMaintainer(tier, limits)
+				msg = maintenance.NewMsgSubmitProposal(title, description, proposalKind, &kycTierLimitMaintainer, proposer)
 			default:
 				return sdkTypes.ErrInternal("Unregonised proposal type.")
 			}
@@ -199,6 +254,13 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String("fee-collector-module", "", "Fee collector has to assign to collect/removed fees for a module.")
 	cmd.Flags().String("action", "add", "Action can be remove or add.")
 	cmd.Flags().String("validator-address", "", "Validator address to be whitelisted or revoke.")
+	cmd.Flags().String("validators-share", "100", "Percentage of the block fees for the validators.")
+	cmd.Flags().String("treasury-share", "0", "Percentage of the block fees for the treasury.")
+	cmd.Flags().String("treasury-address", "", "Treasury address.")
+	cmd.Flags().String("burn-share", "0", "Percentage of the block fees to burn.")
+	cmd.Flags().StringSlice("collector-share", nil, "Percentage of the block fees for the fee collectors of a module, in module:percentage format.")
+	cmd.Flags().String("tier", "", "Kyc tier of the transfer limits.")
+	cmd.Flags().StringSlice("tier-limit", nil, "Daily transfer limit of the kyc tier, in denom:amount format. No limits removes the limits of the tier.")
 	return cmd
 }
 
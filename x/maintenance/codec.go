@@ -18,6 +18,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(TokenMaintainer{}, "maintenance/data/tokenMaintainer", nil)
 	cdc.RegisterConcrete(WhitelistValidator{}, "maintenance/data/whitelistValidator", nil)
 	cdc.RegisterConcrete(NonFungibleMaintainer{}, "maintenance/data/nonFungibleMaintainer", nil)
+	cdc.RegisterConcrete(FeeDistributionMaintainer{}, "maintenance/data/feeDistributionMaintainer", nil)
+	cdc.RegisterConcrete(KycTierLimitMaintainer{}, "maintenance/data/kycTierLimitMaintainer", nil)
 }
 
 var msgCdc = codec.New()
@@ -0,0 +1,48 @@
+package maintenance
+
+import sdkTypes "github.com/cosmos/cosmos-sdk/types"
+
+type FeeDistributionMaintainer struct {
+	Validators      string              `json:"validators"`
+	Treasury        string              `json:"treasury"`
+	TreasuryAddress sdkTypes.AccAddress `json:"treasuryAddress"`
+	Burn            string              `json:"burn"`
+	Collectors      []FeeCollectorShare `json:"collectors"`
+}
+
+type FeeCollectorShare struct {
+	Module     string `json:"module"`
+	Percentage string `json:"percentage"`
+}
+
+func NewFeeDistributionMaintainer(validators, treasury string, treasuryAddress sdkTypes.AccAddress, burn string, collectors []FeeCollectorShare) FeeDistributionMaintainer {
+	return FeeDistributionMaintainer{
+		Validators:      validators,
+		Treasury:        treasury,
+		TreasuryAddress: treasuryAddress,
+		Burn:            burn,
+		Collectors:      collectors,
+	}
+}
+
+var _ MsgProposalData = &FeeDistributionMaintainer{}
+
+func (feeDistributionMaintainer FeeDistributionMaintainer) GetType() ProposalKind {
+	return ProposalTypeModifyFeeDistribution
+}
+
+func (feeDistributionMaintainer *FeeDistributionMaintainer) Unmarshal(data []byte) error {
+	err := msgCdc.UnmarshalBinaryLengthPrefixed(data, feeDistributionMaintainer)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (feeDistributionMaintainer FeeDistributionMaintainer) Marshal() ([]byte, error) {
+	bz, err := msgCdc.MarshalBinaryLengthPrefixed(feeDistributionMaintainer)
+	if err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
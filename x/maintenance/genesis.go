@@ -1,6 +1,8 @@
 package maintenance
 
 import (
+	"fmt"
+
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto"
 )
@@ -9,6 +11,7 @@ type GenesisState struct {
 	Maintainers        []sdkTypes.AccAddress `json:"maintainers"`
 	StartingProposalID uint64                `json:"starting_proposal_id"`
 	ValidatorSet       []string              `json:"validator_set"`
+	Proposals          []Proposal            `json:"proposals"`
 }
 
 func DefaultGenesisState() GenesisState {
@@ -32,11 +35,27 @@ func InitGenesis(ctx sdkTypes.Context, keeper *Keeper, genesisState GenesisState
 	if err != nil {
 		panic(err)
 	}
+
+	for _, proposal := range genesisState.Proposals {
+		if proposal.ProposalID >= genesisState.StartingProposalID {
+			panic(fmt.Sprintf("Invalid proposal ID %d, must be less than starting proposal ID", proposal.ProposalID))
+		}
+		keeper.SetProposal(ctx, proposal)
+	}
 }
 
 func ExportGenesis(ctx sdkTypes.Context, keeper *Keeper) GenesisState {
 	startingProposalID, _ := keeper.peekCurrentProposalID(ctx)
+
+	var validatorSet []string
+	for _, pk := range keeper.GetValidatorSet(ctx) {
+		validatorSet = append(validatorSet, sdkTypes.MustBech32ifyConsPub(pk))
+	}
+
 	return GenesisState{
+		Maintainers:        keeper.getMaintainersAddress(ctx),
 		StartingProposalID: startingProposalID,
+		ValidatorSet:       validatorSet,
+		Proposals:          keeper.GetProposals(ctx),
 	}
 }
@@ -128,6 +128,26 @@ func (keeper Keeper) GetProposal(ctx sdkTypes.Context, proposalID uint64) (propo
 	return proposal, true
 }
 
+// Get all proposals from store
+func (keeper Keeper) GetProposals(ctx sdkTypes.Context) (proposals []Proposal) {
+	store := ctx.KVStore(keeper.maintenanceKey)
+	iter := sdkTypes.KVStorePrefixIterator(store, PrefixProposal)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			break
+		}
+
+		var proposal Proposal
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &proposal)
+		proposals = append(proposals, proposal)
+
+		iter.Next()
+	}
+	return proposals
+}
+
 func (keeper Keeper) IsProposalActive(ctx sdkTypes.Context, proposalId uint64) (active bool) {
 	proposal, ok := keeper.GetProposal(ctx, proposalId)
 	if !ok {
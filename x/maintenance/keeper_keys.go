@@ -10,6 +10,7 @@ const (
 
 var (
 	KeyNextProposalID = []byte("newProposalID")
+	PrefixProposal    = []byte("maintenance/proposal:")
 
 	PrefixValidatorSet = []byte("0x033")
 )
@@ -0,0 +1,41 @@
+package maintenance
+
+// KycTierLimitMaintainer replaces the daily transfer limits of a kyc tier, no limits removes them.
+type KycTierLimitMaintainer struct {
+	Tier   string                 `json:"tier"`
+	Limits []KycTierTransferLimit `json:"limits"`
+}
+
+type KycTierTransferLimit struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+func NewKycTierLimitMaintainer(tier string, limits []KycTierTransferLimit) KycTierLimitMaintainer {
+	return KycTierLimitMaintainer{
+		Tier:   tier,
+		Limits: limits,
+	}
+}
+
+var _ MsgProposalData = &KycTierLimitMaintainer{}
+
+func (kycTierLimitMaintainer KycTierLimitMaintainer) GetType() ProposalKind {
+	return ProposalTypeModifyKycTierLimit
+}
+
+func (kycTierLimitMaintainer *KycTierLimitMaintainer) Unmarshal(data []byte) error {
+	err := msgCdc.UnmarshalBinaryLengthPrefixed(data, kycTierLimitMaintainer)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (kycTierLimitMaintainer KycTierLimitMaintainer) Marshal() ([]byte, error) {
+	bz, err := msgCdc.MarshalBinaryLengthPrefixed(kycTierLimitMaintainer)
+	if err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
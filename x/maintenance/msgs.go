@@ -21,12 +21,14 @@ type ProposalKind byte
 
 //nolint
 const (
-	ProposalTypeModifyFee           ProposalKind = 0x01
-	ProposalTypeModifyToken         ProposalKind = 0x02
-	ProposalTypeModifyNameservice   ProposalKind = 0x03
-	ProposalTypeModifyKyc           ProposalKind = 0x04
-	ProposalTypesModifyValidatorSet ProposalKind = 0x05
-	ProposalTypeModifyNonFungible   ProposalKind = 0x06
+	ProposalTypeModifyFee             ProposalKind = 0x01
+	ProposalTypeModifyToken           ProposalKind = 0x02
+	ProposalTypeModifyNameservice     ProposalKind = 0x03
+	ProposalTypeModifyKyc             ProposalKind = 0x04
+	ProposalTypesModifyValidatorSet   ProposalKind = 0x05
+	ProposalTypeModifyNonFungible     ProposalKind = 0x06
+	ProposalTypeModifyFeeDistribution ProposalKind = 0x07
+	ProposalTypeModifyKycTierLimit    ProposalKind = 0x08
 )
 
 // MsgSubmitProposal
@@ -23,3 +23,25 @@ func TestEncode(t *testing.T) {
 	assert.NoError(t, err2)
 	fmt.Println(string(bz))
 }
+
+func TestProposalKindJSON(t *testing.T) {
+	kinds := []ProposalKind{ProposalTypeModifyFee, ProposalTypeModifyToken, ProposalTypeModifyNameservice, ProposalTypeModifyKyc,
+		ProposalTypesModifyValidatorSet, ProposalTypeModifyNonFungible, ProposalTypeModifyFeeDistribution, ProposalTypeModifyKycTierLimit}
+
+	for _, kind := range kinds {
+		bz, err := json.Marshal(kind)
+		assert.NoError(t, err)
+
+		var decoded ProposalKind
+		assert.NoError(t, json.Unmarshal(bz, &decoded))
+		assert.Equal(t, kind, decoded)
+	}
+
+	// the names of the cli are accepted too
+	var decoded ProposalKind
+	assert.NoError(t, json.Unmarshal([]byte(`"fee"`), &decoded))
+	assert.Equal(t, ProposalTypeModifyFee, decoded)
+
+	_, err := ProposalTypeFromString("ModifyFeeMaintainer")
+	assert.Error(t, err)
+}
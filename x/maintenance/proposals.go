@@ -81,6 +81,10 @@ func ProposalTypeFromString(str string) (ProposalKind, error) {
 		return ProposalTypesModifyValidatorSet, nil
 	case "nonFungible":
 		return ProposalTypeModifyNonFungible, nil
+	case "feeDistribution":
+		return ProposalTypeModifyFeeDistribution, nil
+	case "kycTierLimit":
+		return ProposalTypeModifyKycTierLimit, nil
 	default:
 		return ProposalKind(0xff), fmt.Errorf("'%s' is not a valid proposal type", str)
 	}
@@ -93,7 +97,9 @@ func validProposalType(pt ProposalKind) bool {
 		pt == ProposalTypeModifyNameservice ||
 		pt == ProposalTypeModifyToken ||
 		pt == ProposalTypesModifyValidatorSet ||
-		pt == ProposalTypeModifyNonFungible {
+		pt == ProposalTypeModifyNonFungible ||
+		pt == ProposalTypeModifyFeeDistribution ||
+		pt == ProposalTypeModifyKycTierLimit {
 		return true
 	}
 	return false
@@ -115,7 +121,7 @@ func (pt ProposalKind) MarshalJSON() ([]byte, error) {
 	return json.Marshal(pt.String())
 }
 
-// Unmarshals from JSON assuming Bech32 encoding
+// Unmarshals from JSON, accepts the string MarshalJSON writes so an exported genesis can be imported
 func (pt *ProposalKind) UnmarshalJSON(data []byte) error {
 	var s string
 	err := json.Unmarshal(data, &s)
@@ -123,6 +129,13 @@ func (pt *ProposalKind) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	for kind := ProposalKind(0); kind < ProposalKind(0xff); kind++ {
+		if validProposalType(kind) && kind.String() == s {
+			*pt = kind
+			return nil
+		}
+	}
+
 	bz2, err := ProposalTypeFromString(s)
 	if err != nil {
 		return err
@@ -144,6 +157,10 @@ func (pt ProposalKind) String() string {
 		return "ModifyTokenMaintainer"
 	case ProposalTypeModifyNonFungible:
 		return "ModifyNonFungibleMaintainer"
+	case ProposalTypeModifyFeeDistribution:
+		return "ModifyFeeDistribution"
+	case ProposalTypeModifyKycTierLimit:
+		return "ModifyKycTierLimit"
 	default:
 		return ""
 	}
@@ -181,7 +198,7 @@ func ProposalStatusFromString(str string) (ProposalStatus, error) {
 		return StatusActive, nil
 	case "Completed":
 		return StatusCompleted, nil
-	case "Rejeced":
+	case "Rejected":
 		return StatusRejected, nil
 	default:
 		return ProposalStatus(0xff), fmt.Errorf("'%s' is not a valid proposal status", str)
@@ -8,7 +8,8 @@ type GenesisState struct {
 	AuthorisedAddresses []sdkTypes.AccAddress `json:"authorised_addresses"`
 	IssuerAddresses     []sdkTypes.AccAddress `json:"issuer_addresses"`
 	ProviderAddresses   []sdkTypes.AccAddress `json:"provider_addresses"`
-	GenesisAliasOwners  []genesisAliasOwner   `json:"genesis_alias_owners"`
+	GenesisAliasOwners  []GenesisAliasOwner   `json:"genesis_alias_owners"`
+	Aliases             []Alias               `json:"aliases"`
 }
 
 func DefaultGenesisState() GenesisState {
@@ -49,32 +50,49 @@ func InitGenesis(ctx sdkTypes.Context, keeper Keeper, genesisState GenesisState)
 
 	for _, genesisAliasOwner := range genesisState.GenesisAliasOwners {
 		alias := &Alias{
-			Name:     genesisAliasOwner.alias,
-			Owner:    genesisAliasOwner.owner,
+			Name:     genesisAliasOwner.Alias,
+			Owner:    genesisAliasOwner.Owner,
 			Metadata: "genesis alias owner",
 			Approved: false,
 			Fee:      sdkTypes.NewUintFromString("0"),
 		}
 
 		aliasOwner := &AliasOwner{
-			Name:     genesisAliasOwner.alias,
+			Name:     genesisAliasOwner.Alias,
 			Approved: false,
 		}
-		keeper.storeAlias(ctx, genesisAliasOwner.alias, alias, aliasOwner)
+		keeper.storeAlias(ctx, genesisAliasOwner.Alias, alias, aliasOwner)
 
 		alias.Approved = true
 		aliasOwner.Approved = true
 		keeper.setAlias(ctx, alias.Name, alias, aliasOwner)
 	}
+
+	for i := range genesisState.Aliases {
+		alias := genesisState.Aliases[i]
+		aliasOwner := &AliasOwner{
+			Name:     alias.Name,
+			Approved: alias.Approved,
+		}
+
+		if alias.Approved {
+			keeper.setAlias(ctx, alias.Name, &alias, aliasOwner)
+		} else {
+			keeper.storeAlias(ctx, alias.Name, &alias, aliasOwner)
+		}
+	}
 }
 
-func ExportGenesis(keeper *Keeper) GenesisState {
+func ExportGenesis(ctx sdkTypes.Context, keeper *Keeper) GenesisState {
 	return GenesisState{
-		AuthorisedAddresses: keeper.authorizedAddresses,
+		AuthorisedAddresses: keeper.GetAuthorisedAddresses(ctx),
+		IssuerAddresses:     keeper.GetIssuerAddresses(ctx),
+		ProviderAddresses:   keeper.GetProviderAddresses(ctx),
+		Aliases:             keeper.ListAllAliases(ctx),
 	}
 }
 
-type genesisAliasOwner struct {
-	alias string              `json:"alias"`
-	owner sdkTypes.AccAddress `json:"owner"`
+type GenesisAliasOwner struct {
+	Alias string              `json:"alias"`
+	Owner sdkTypes.AccAddress `json:"owner"`
 }
@@ -426,6 +426,31 @@ func (k *Keeper) ListUsedAlias(ctx sdkTypes.Context) []string {
 	return alias
 }
 
+// ListAllAliases returns both the pending and the approved aliases.
+func (k *Keeper) ListAllAliases(ctx sdkTypes.Context) []Alias {
+	var aliases = make([]Alias, 0)
+
+	for _, storeKey := range []sdkTypes.StoreKey{k.namesStoreKey, k.ownersStoreKey} {
+		store := ctx.KVStore(storeKey)
+		iter := store.Iterator([]byte("alias:"), []byte("alias;"))
+
+		for {
+			if !iter.Valid() {
+				break
+			}
+
+			var alias = new(Alias)
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &alias)
+			aliases = append(aliases, *alias)
+
+			iter.Next()
+		}
+		iter.Close()
+	}
+
+	return aliases
+}
+
 func (k Keeper) RevokeAlias(ctx sdkTypes.Context, alias string, signer sdkTypes.AccAddress) sdkTypes.Result {
 
 	if !k.IsAuthorised(ctx, signer) {
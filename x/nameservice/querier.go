@@ -1,6 +1,9 @@
 package nameservice
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
@@ -84,23 +87,28 @@ func queryWhois(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 
 func queryGetFee(cdc *codec.Codec, ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper, feeKeeper fee.Keeper) ([]byte, sdk.Error) {
 
-	msgType := path[0]
-	totalAmount, parseCoinsErr := sdk.ParseCoins("")
-	if parseCoinsErr != nil {
-		return nil, sdk.ErrInvalidCoins("Invalid amount")
+	if len(path) != 1 && len(path) != 2 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
 	}
 
-	feeSetting, feeSettingErr := feeKeeper.GetMsgFeeSetting(ctx, msgType)
-	if feeSettingErr != nil {
-		return nil, feeSettingErr
+	msgType := path[0]
+
+	// optional signer, the account fee setting of the signer overrides the msg fee setting
+	var signer sdk.AccAddress
+	if len(path) == 2 {
+		var err error
+		signer, err = sdk.AccAddressFromBech32(path[1])
+		if err != nil {
+			return nil, sdk.ErrInvalidAddress(path[1])
+		}
 	}
 
-	calculatedFee, calculatedFeeErr := fee.DefaultCalculateFee(ctx, feeSetting, totalAmount)
-	if calculatedFeeErr != nil {
-		return nil, calculatedFeeErr
+	quote, quoteErr := feeKeeper.QuoteFee(ctx, fee.NewFeeRequest(msgType, signer, nil))
+	if quoteErr != nil {
+		return nil, quoteErr
 	}
 
-	respData := sdk.MustSortJSON(codec.Cdc.MustMarshalJSON(calculatedFee))
+	respData := sdk.MustSortJSON(codec.Cdc.MustMarshalJSON(quote.FeeAmount()))
 
 	return respData, nil
 
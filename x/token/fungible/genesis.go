@@ -1,6 +1,8 @@
 package fungible
 
 import (
+	"fmt"
+
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,6 +10,13 @@ type GenesisState struct {
 	AuthorizedAddresses []sdkTypes.AccAddress `json:"authorised_addresses"`
 	IssuerAddresses     []sdkTypes.AccAddress `json:"issuer_addresses"`
 	ProviderAddresses   []sdkTypes.AccAddress `json:"provider_addresses"`
+	Tokens              []Token               `json:"tokens"`
+	TokenAccounts       []GenesisTokenAccount `json:"token_accounts"`
+}
+
+type GenesisTokenAccount struct {
+	Symbol  string               `json:"symbol"`
+	Account FungibleTokenAccount `json:"account"`
 }
 
 func DefaultGenesisState() GenesisState {
@@ -46,8 +55,39 @@ func InitGenesis(ctx sdkTypes.Context, keeper *Keeper, genesisState GenesisState
 		validProviderAddresses = append(validProviderAddresses, providerAdd)
 	}
 	keeper.SetProviderAddresses(ctx, validProviderAddresses)
+
+	for i := range genesisState.Tokens {
+		token := genesisState.Tokens[i]
+		keeper.storeToken(ctx, token.Symbol, &token)
+	}
+
+	for i := range genesisState.TokenAccounts {
+		tokenAccount := genesisState.TokenAccounts[i]
+		if !keeper.TokenExists(ctx, tokenAccount.Symbol) {
+			panic(fmt.Sprintf("Invalid token account, token %s not found", tokenAccount.Symbol))
+		}
+		keeper.storeFungibleAccount(ctx, tokenAccount.Symbol, &tokenAccount.Account)
+	}
 }
 
-func ExportGenesis(keeper *Keeper) GenesisState {
-	return GenesisState{}
+func ExportGenesis(ctx sdkTypes.Context, keeper *Keeper) GenesisState {
+	tokens := keeper.ListTokens(ctx)
+
+	var tokenAccounts []GenesisTokenAccount
+	for _, token := range tokens {
+		for _, account := range keeper.ListFungibleAccounts(ctx, token.Symbol) {
+			tokenAccounts = append(tokenAccounts, GenesisTokenAccount{
+				Symbol:  token.Symbol,
+				Account: account,
+			})
+		}
+	}
+
+	return GenesisState{
+		AuthorizedAddresses: keeper.GetAuthorisedAddresses(ctx),
+		IssuerAddresses:     keeper.GetIssuerAddresses(ctx),
+		ProviderAddresses:   keeper.GetProviderAddresses(ctx),
+		Tokens:              tokens,
+		TokenAccounts:       tokenAccounts,
+	}
 }
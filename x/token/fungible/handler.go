@@ -18,7 +18,12 @@ const (
 	RejectTransferTokenOwnership  = "REJECT_TRANFER_TOKEN_OWNERSHIP"
 )
 
-func NewHandler(keeper *Keeper) sdkTypes.Handler {
+// TransferLimitKeeper records the transfers of an account against the transfer limits of its kyc tier.
+type TransferLimitKeeper interface {
+	ConsumeTransferLimit(ctx sdkTypes.Context, addr sdkTypes.AccAddress, denom string, amount sdkTypes.Uint) sdkTypes.Error
+}
+
+func NewHandler(keeper *Keeper, transferLimitKeeper TransferLimitKeeper) sdkTypes.Handler {
 	return func(ctx sdkTypes.Context, msg sdkTypes.Msg) sdkTypes.Result {
 		switch msg := msg.(type) {
 		case MsgCreateFungibleToken:
@@ -28,7 +33,7 @@ func NewHandler(keeper *Keeper) sdkTypes.Handler {
 		case MsgMintFungibleToken:
 			return handleMsgMintFungibleToken(ctx, keeper, msg)
 		case MsgTransferFungibleToken:
-			return handleMsgTransferFungibleToken(ctx, keeper, msg)
+			return handleMsgTransferFungibleToken(ctx, keeper, transferLimitKeeper, msg)
 		case MsgBurnFungibleToken:
 			return handleMsgBurnFungibleToken(ctx, keeper, msg)
 		case MsgSetFungibleTokenAccountStatus:
@@ -81,7 +86,11 @@ func handleMsgMintFungibleToken(ctx sdkTypes.Context, keeper *Keeper, msg MsgMin
 	return keeper.MintFungibleToken(ctx, msg.Symbol, msg.Owner, msg.To, msg.Value)
 }
 
-func handleMsgTransferFungibleToken(ctx sdkTypes.Context, keeper *Keeper, msg MsgTransferFungibleToken) sdkTypes.Result {
+func handleMsgTransferFungibleToken(ctx sdkTypes.Context, keeper *Keeper, transferLimitKeeper TransferLimitKeeper, msg MsgTransferFungibleToken) sdkTypes.Result {
+	if err := transferLimitKeeper.ConsumeTransferLimit(ctx, msg.From, msg.Symbol, msg.Value); err != nil {
+		return err.Result()
+	}
+
 	return keeper.TransferFungibleToken(ctx, msg.Symbol, msg.From, msg.To, msg.Value)
 }
 
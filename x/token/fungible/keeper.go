@@ -336,7 +336,7 @@ func (k *Keeper) approveFungibleToken(ctx sdkTypes.Context, symbol string, token
 		if !k.feeKeeper.FeeSettingExists(ctx, tokenFee.FeeName) {
 			return types.ErrFeeSettingNotExists(tokenFee.FeeName).Result()
 		}
-		err := k.feeKeeper.AssignFeeToTokenAction(ctx, tokenFee.FeeName, token.Symbol, tokenFee.Action)
+		err := k.feeKeeper.AssignFeeToTokenAction(ctx, tokenFee.FeeName, token.Symbol, tokenFee.Action, signer)
 		if err != nil {
 			return err.Result()
 		}
@@ -797,6 +797,29 @@ func (k *Keeper) ListTokens(ctx sdkTypes.Context) []Token {
 	return lst
 }
 
+// ListFungibleAccounts returns every account that holds the given token.
+func (k *Keeper) ListFungibleAccounts(ctx sdkTypes.Context, symbol string) []FungibleTokenAccount {
+	store := ctx.KVStore(k.key)
+	start := []byte(symbol + ":")
+	end := []byte(symbol + ";")
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+
+	var lst = make([]FungibleTokenAccount, 0)
+
+	for {
+		if !iter.Valid() {
+			break
+		}
+		var account = new(FungibleTokenAccount)
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), account)
+		lst = append(lst, *account)
+
+		iter.Next()
+	}
+	return lst
+}
+
 func (k *Keeper) GetTokenData(ctx sdkTypes.Context, symbol string) (interface{}, sdkTypes.Error) {
 	res, err := k.mustGetAnyTokenData(ctx, symbol)
 	if err != nil {
@@ -146,6 +146,51 @@ func (k *Keeper) TransferFungibleToken(ctx sdkTypes.Context, symbol string, from
 
 }
 
+// PayFee moves the transaction fee paid in the token from the payer to the fee collector.
+func (k *Keeper) PayFee(ctx sdkTypes.Context, symbol string, payer, collector sdkTypes.AccAddress, value sdkTypes.Uint) sdkTypes.Error {
+	var token = new(Token)
+	if exists := k.getTokenData(ctx, symbol, token); !exists {
+		return types.ErrInvalidTokenSymbol(symbol)
+	}
+
+	if !token.Flags.HasFlag(ApprovedFlag) {
+		return types.ErrTokenInvalid()
+	}
+
+	if token.Flags.HasFlag(FrozenFlag) {
+		return types.ErrTokenFrozen()
+	}
+
+	payerAccount := k.getFungibleAccount(ctx, symbol, payer)
+	if payerAccount == nil {
+		return types.ErrInvalidTokenAccount()
+	}
+
+	if payerAccount.Frozen {
+		return types.ErrTokenAccountFrozen()
+	}
+
+	if payerAccount.Balance.LT(value) {
+		return types.ErrInvalidTokenAccountBalance(fmt.Sprintf("Not enough tokens to pay fee. Have only %v", payerAccount.Balance.String()))
+	}
+
+	collectorAccount := k.getFungibleAccount(ctx, symbol, collector)
+	if collectorAccount == nil {
+		collectorAccount = k.createFungibleAccount(ctx, symbol, collector)
+	}
+
+	if collectorAccount.Frozen {
+		return types.ErrTokenAccountFrozen()
+	}
+
+	subFungibleTokenErr := k.subFungibleToken(ctx, symbol, payer, value)
+	if subFungibleTokenErr != nil {
+		return subFungibleTokenErr
+	}
+
+	return k.addFungibleToken(ctx, symbol, collector, value)
+}
+
 // BurnFungibleToken
 func (k *Keeper) BurnFungibleToken(ctx sdkTypes.Context, symbol string, owner sdkTypes.AccAddress, value sdkTypes.Uint) sdkTypes.Result {
 	var token = new(Token)
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/maxonrow/maxonrow-go/types"
 	"github.com/maxonrow/maxonrow-go/x/fee"
 )
 
@@ -27,6 +28,8 @@ func NewQuerier(cdc *codec.Codec, keeper *Keeper, feeKeeper *fee.Keeper) sdkType
 			return queryTokenData(cdc, ctx, path[1:], req, keeper)
 		case QueryAccount:
 			return queryAccount(cdc, ctx, path[1:], req, keeper)
+		case QueryGetFee:
+			return queryGetFee(ctx, path[1:], req, feeKeeper)
 		default:
 			return nil, sdkTypes.ErrUnknownRequest("unknown token query endpoint")
 		}
@@ -94,3 +97,50 @@ type listTokenSymbolResponse struct {
 	Fungible    []string `json:"fungible"`
 	Nonfungible []string `json:"nonfungible"`
 }
+
+// msg types of the token actions, used when the token has no fee setting for the action
+var feeActionMsgTypes = map[string]string{
+	fee.TransferFungibleToken:  MsgTypeTransferFungibleToken,
+	fee.MintFungibleToken:      MsgTypeMintFungibleToken,
+	fee.BurnFungibleToken:      MsgTypeBurnFungibleToken,
+	fee.TransferTokenOwnership: MsgTypeTransferFungibleTokenOwnership,
+	fee.AcceptTokenOwnership:   MsgTypeAcceptFungibleTokenOwnership,
+}
+
+func queryGetFee(ctx sdkTypes.Context, path []string, _ abci.RequestQuery, feeKeeper *fee.Keeper) ([]byte, sdkTypes.Error) {
+	if len(path) != 3 && len(path) != 4 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	symbol := path[0]
+	action := path[1]
+
+	msgType, ok := feeActionMsgTypes[action]
+	if !ok {
+		return nil, types.ErrInvalidTokenAction()
+	}
+
+	amount, parseCoinsErr := sdkTypes.ParseCoins(path[2] + types.CIN)
+	if parseCoinsErr != nil {
+		return nil, sdkTypes.ErrInvalidCoins("Invalid amount")
+	}
+
+	// optional signer, the account fee setting of the signer overrides the token fee setting
+	var signer sdkTypes.AccAddress
+	if len(path) == 4 {
+		var err error
+		signer, err = sdkTypes.AccAddressFromBech32(path[3])
+		if err != nil {
+			return nil, sdkTypes.ErrInvalidAddress(path[3])
+		}
+	}
+
+	quote, quoteErr := feeKeeper.QuoteFee(ctx, fee.NewTokenFeeRequest(MsgRoute+"-"+msgType, signer, symbol, action, amount))
+	if quoteErr != nil {
+		return nil, quoteErr
+	}
+
+	respData := sdkTypes.MustSortJSON(codec.Cdc.MustMarshalJSON(quote.FeeAmount()))
+
+	return respData, nil
+}
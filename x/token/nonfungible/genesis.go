@@ -0,0 +1,112 @@
+package nonfungible
+
+import (
+	"fmt"
+
+	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState of a new chain has the authorised, issuer and provider addresses, the tokens, items
+// and mint limits are exported from a running chain or loaded with mxwd init --nonfungible-genesis.
+type GenesisState struct {
+	AuthorizedAddresses []sdkTypes.AccAddress `json:"authorised_addresses"`
+	IssuerAddresses     []sdkTypes.AccAddress `json:"issuer_addresses"`
+	ProviderAddresses   []sdkTypes.AccAddress `json:"provider_addresses"`
+	Tokens              []Token               `json:"tokens"`
+	Items               []GenesisItem         `json:"items"`
+	MintLimits          []GenesisMintLimit    `json:"mint_limits"`
+}
+
+type GenesisItem struct {
+	Symbol string              `json:"symbol"`
+	Owner  sdkTypes.AccAddress `json:"owner"`
+	Item   Item                `json:"item"`
+}
+
+// GenesisMintLimit keeps the number of items minted to an owner, it is checked against the token mint limit.
+type GenesisMintLimit struct {
+	Symbol  string              `json:"symbol"`
+	Owner   sdkTypes.AccAddress `json:"owner"`
+	Counter sdkTypes.Uint       `json:"counter"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+func InitGenesis(ctx sdkTypes.Context, keeper *Keeper, genesisState GenesisState) {
+	var validAuthorizedAddresses []sdkTypes.AccAddress
+
+	for _, AuthorizedAddressesString := range genesisState.AuthorizedAddresses {
+		authorisedAddress, err := sdkTypes.AccAddressFromBech32(AuthorizedAddressesString.String())
+
+		if err != nil {
+			panic("Invalid authorised address")
+		}
+		validAuthorizedAddresses = append(validAuthorizedAddresses, authorisedAddress)
+	}
+	keeper.SetAuthorisedAddresses(ctx, validAuthorizedAddresses)
+
+	var validIssuerAddresses []sdkTypes.AccAddress
+	for _, issuerAddress := range genesisState.IssuerAddresses {
+		issuerAdd, err := sdkTypes.AccAddressFromBech32(issuerAddress.String())
+		if err != nil {
+			panic("Invalid issuer address")
+		}
+		validIssuerAddresses = append(validIssuerAddresses, issuerAdd)
+	}
+	keeper.SetIssuerAddresses(ctx, validIssuerAddresses)
+
+	var validProviderAddresses []sdkTypes.AccAddress
+	for _, providerAddress := range genesisState.ProviderAddresses {
+		providerAdd, err := sdkTypes.AccAddressFromBech32(providerAddress.String())
+		if err != nil {
+			panic("Invalid provider address")
+		}
+		validProviderAddresses = append(validProviderAddresses, providerAdd)
+	}
+	keeper.SetProviderAddresses(ctx, validProviderAddresses)
+
+	for i := range genesisState.Tokens {
+		token := genesisState.Tokens[i]
+		keeper.storeToken(ctx, token.Symbol, &token)
+	}
+
+	for i := range genesisState.Items {
+		genesisItem := genesisState.Items[i]
+		if !keeper.TokenExists(ctx, genesisItem.Symbol) {
+			panic(fmt.Sprintf("Invalid item, token %s not found", genesisItem.Symbol))
+		}
+		if genesisItem.Owner.Empty() {
+			panic(fmt.Sprintf("Invalid item %s, owner is empty", genesisItem.Item.ID))
+		}
+		keeper.storeNonFungibleItem(ctx, genesisItem.Symbol, genesisItem.Owner, &genesisItem.Item)
+	}
+
+	for _, mintLimit := range genesisState.MintLimits {
+		if !keeper.TokenExists(ctx, mintLimit.Symbol) {
+			panic(fmt.Sprintf("Invalid mint limit, token %s not found", mintLimit.Symbol))
+		}
+		keeper.setMintItemLimit(ctx, mintLimit.Symbol, mintLimit.Owner, mintLimit.Counter)
+	}
+}
+
+func ExportGenesis(ctx sdkTypes.Context, keeper *Keeper) GenesisState {
+	tokens := keeper.ListTokens(ctx)
+
+	var items []GenesisItem
+	var mintLimits []GenesisMintLimit
+	for _, token := range tokens {
+		items = append(items, keeper.ListItems(ctx, token.Symbol)...)
+		mintLimits = append(mintLimits, keeper.ListMintItemLimits(ctx, token.Symbol)...)
+	}
+
+	return GenesisState{
+		AuthorizedAddresses: keeper.GetAuthorisedAddresses(ctx),
+		IssuerAddresses:     keeper.GetIssuerAddresses(ctx),
+		ProviderAddresses:   keeper.GetProviderAddresses(ctx),
+		Tokens:              tokens,
+		Items:               items,
+		MintLimits:          mintLimits,
+	}
+}
@@ -330,7 +330,7 @@ func (k *Keeper) approveNonFungibleToken(ctx sdkTypes.Context, symbol string, to
 		if !k.feeKeeper.FeeSettingExists(ctx, tokenFee.FeeName) {
 			return types.ErrFeeSettingNotExists(tokenFee.FeeName).Result()
 		}
-		err := k.feeKeeper.AssignFeeToTokenAction(ctx, tokenFee.FeeName, token.Symbol, tokenFee.Action)
+		err := k.feeKeeper.AssignFeeToTokenAction(ctx, tokenFee.FeeName, token.Symbol, tokenFee.Action, signer)
 		if err != nil {
 			return err.Result()
 		}
@@ -699,6 +699,13 @@ func (k *Keeper) increaseMintItemLimit(ctx sdkTypes.Context, symbol string, owne
 
 }
 
+func (k *Keeper) setMintItemLimit(ctx sdkTypes.Context, symbol string, owner sdkTypes.AccAddress, counter sdkTypes.Uint) {
+	store := ctx.KVStore(k.key)
+	key := getMintItemLimitKey(symbol, owner)
+
+	store.Set(key, []byte(counter.String()))
+}
+
 // Item
 func (k *Keeper) getNonFungibleItem(ctx sdkTypes.Context, symbol string, itemID string) *Item {
 	itemKey := getNonFungibleItemKey(symbol, []byte(itemID))
@@ -937,3 +944,57 @@ func (k *Keeper) ListTokens(ctx sdkTypes.Context) []Token {
 	}
 	return lst
 }
+
+// ListItems returns all the items of the given token together with their owners.
+func (k *Keeper) ListItems(ctx sdkTypes.Context, symbol string) []GenesisItem {
+	store := ctx.KVStore(k.key)
+	prefix := getNonFungibleItemKey(symbol, nil)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	var lst = make([]GenesisItem, 0)
+
+	for {
+		if !iter.Valid() {
+			break
+		}
+		var item = new(Item)
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), item)
+		lst = append(lst, GenesisItem{
+			Symbol: symbol,
+			Owner:  k.getNonFungibleItemOwner(ctx, symbol, item.ID),
+			Item:   *item,
+		})
+
+		iter.Next()
+	}
+	return lst
+}
+
+// ListMintItemLimits returns the mint item counters of the given token.
+func (k *Keeper) ListMintItemLimits(ctx sdkTypes.Context, symbol string) []GenesisMintLimit {
+	store := ctx.KVStore(k.key)
+	prefix := getMintItemLimitKey(symbol, nil)
+	iter := sdkTypes.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	var lst = make([]GenesisMintLimit, 0)
+
+	for {
+		if !iter.Valid() {
+			break
+		}
+		// fungible token account shares the same key layout, skip anything that is not a counter.
+		counter, err := sdkTypes.ParseUint(string(iter.Value()))
+		if len(iter.Key()) == len(prefix)+sdkTypes.AddrLen && err == nil {
+			lst = append(lst, GenesisMintLimit{
+				Symbol:  symbol,
+				Owner:   sdkTypes.AccAddress(iter.Key()[len(prefix):]),
+				Counter: counter,
+			})
+		}
+
+		iter.Next()
+	}
+	return lst
+}
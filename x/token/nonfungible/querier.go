@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkTypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maxonrow/maxonrow-go/types"
 	"github.com/maxonrow/maxonrow-go/x/fee"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -28,6 +29,8 @@ func NewQuerier(cdc *codec.Codec, keeper *Keeper, feeKeeper *fee.Keeper) sdkType
 			return queryTokenData(cdc, ctx, path[1:], req, keeper)
 		case QueryItemData:
 			return queryItemData(cdc, ctx, path[1:], req, keeper)
+		case QueryGetFee:
+			return queryGetFee(ctx, path[1:], req, feeKeeper)
 		default:
 			return nil, sdkTypes.ErrUnknownRequest("unknown token query endpoint")
 		}
@@ -82,3 +85,48 @@ type listTokenSymbolResponse struct {
 	Fungible    []string `json:"fungible"`
 	Nonfungible []string `json:"nonfungible"`
 }
+
+// msg types of the token actions, used when the token has no fee setting for the action
+var feeActionMsgTypes = map[string]string{
+	fee.TransferNonFungibleItem:           MsgTypeTransferNonFungibleToken,
+	fee.MintNonFungibleItem:               MsgTypeMintNonFungibleToken,
+	fee.BurnNonFungibleItem:               MsgTypeBurnNonFungibleToken,
+	fee.TransferNonFungibleTokenOwnership: MsgTypeTransferNonFungibleTokenOwnership,
+	fee.AcceptNonFungibleTokenOwnership:   MsgTypeAcceptNonFungibleTokenOwnership,
+	fee.EndorseNonFungibleItem:            MsgTypeEndorsement,
+	fee.UpdateNonFungibleItem:             MsgTypeUpdateItemMetadata,
+	fee.UpdateNonFungibleMetadata:         MsgTypeUpdateNFTMetadata,
+}
+
+func queryGetFee(ctx sdkTypes.Context, path []string, _ abci.RequestQuery, feeKeeper *fee.Keeper) ([]byte, sdkTypes.Error) {
+	if len(path) != 2 && len(path) != 3 {
+		return nil, sdkTypes.ErrUnknownRequest(fmt.Sprintf("Invalid path %s", strings.Join(path, "/")))
+	}
+
+	symbol := path[0]
+	action := path[1]
+
+	msgType, ok := feeActionMsgTypes[action]
+	if !ok {
+		return nil, types.ErrInvalidTokenAction()
+	}
+
+	// optional signer, the account fee setting of the signer overrides the token fee setting
+	var signer sdkTypes.AccAddress
+	if len(path) == 3 {
+		var err error
+		signer, err = sdkTypes.AccAddressFromBech32(path[2])
+		if err != nil {
+			return nil, sdkTypes.ErrInvalidAddress(path[2])
+		}
+	}
+
+	quote, quoteErr := feeKeeper.QuoteFee(ctx, fee.NewTokenFeeRequest(MsgRoute+"-"+msgType, signer, symbol, action, nil))
+	if quoteErr != nil {
+		return nil, quoteErr
+	}
+
+	respData := sdkTypes.MustSortJSON(codec.Cdc.MustMarshalJSON(quote.FeeAmount()))
+
+	return respData, nil
+}